@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// schematics-inventory is an Ansible inventory script for the hosts of a Schematics inventory.
+//
+// Usage:
+//
+//	SCHEMATICS_INVENTORY_ID=<id> ansible-playbook -i schematics-inventory site.yml
+//	schematics-inventory --list [--inventory <id>]
+//	schematics-inventory --host <host> [--inventory <id>]
+//	schematics-inventory --list --ini <file>
+//
+// The service is configured from the environment (e.g. SCHEMATICS_URL and SCHEMATICS_APIKEY), as for
+// schematicsv1.NewSchematicsV1UsingExternalConfig. With --ini, a local INI inventory file is converted without
+// calling the service.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func main() {
+	list := flag.Bool("list", false, "print the inventory")
+	host := flag.String("host", "", "print the variables of a host")
+	inventoryID := flag.String("inventory", os.Getenv("SCHEMATICS_INVENTORY_ID"), "the ID of the Schematics inventory (default $SCHEMATICS_INVENTORY_ID)")
+	iniFile := flag.String("ini", "", "a local INI inventory file to convert instead of a Schematics inventory")
+	flag.Parse()
+
+	if !*list && *host == "" {
+		fmt.Fprintln(os.Stderr, "one of --list or --host is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+	inventory, err := resolve(*inventoryID, *iniFile)
+	if inventory == nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err != nil {
+		// Partial results, e.g. if some resource queries failed, are still useful to Ansible.
+		fmt.Fprintln(os.Stderr, "warning:", err)
+	}
+
+	var output interface{} = inventory
+	if !*list {
+		output = inventory.Host(*host)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func resolve(inventoryID string, iniFile string) (*schematicsv1.DynamicInventory, error) {
+	if iniFile != "" {
+		content, err := os.ReadFile(iniFile)
+		if err != nil {
+			return nil, err
+		}
+		ini, err := schematicsv1.ParseInventory(string(content))
+		if err != nil {
+			return nil, err
+		}
+		inventory := schematicsv1.NewDynamicInventory()
+		if err := inventory.AddInventory(ini); err != nil {
+			return nil, err
+		}
+		return inventory, nil
+	}
+
+	if inventoryID == "" {
+		return nil, fmt.Errorf("--inventory or SCHEMATICS_INVENTORY_ID is required")
+	}
+	service, err := schematicsv1.NewSchematicsV1UsingExternalConfig(&schematicsv1.SchematicsV1Options{})
+	if err != nil {
+		return nil, err
+	}
+	return service.ResolveInventory(context.Background(), inventoryID)
+}
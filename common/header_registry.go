@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderNameRequestID is the conventional header used to identify a single request.
+	HeaderNameRequestID = "X-Request-ID"
+
+	// HeaderNameCorrelationID is the conventional header used to correlate requests across systems.
+	HeaderNameCorrelationID = "X-Correlation-ID"
+)
+
+// HeaderProvider - computes additional headers for an outgoing request.
+// It receives the same parameters as GetSdkHeaders and may return nil when it has nothing to add.
+type HeaderProvider func(serviceName string, serviceVersion string, operationId string) map[string]string
+
+// headerRegistry holds the application-supplied configuration used by GetSdkHeaders.
+// Its fields are replaced rather than modified in place, so GetSdkHeaders can use a snapshot without
+// holding the lock while it invokes header providers.
+type headerRegistry struct {
+	sync.RWMutex
+	appInfo            string
+	requestIDHeaders   []string
+	requestIDGenerator func() string
+	operationHeaders   map[string]map[string]string
+	providers          []HeaderProvider
+}
+
+var registry = newHeaderRegistry()
+
+func newHeaderRegistry() *headerRegistry {
+	return &headerRegistry{
+		requestIDGenerator: uuid.NewString,
+	}
+}
+
+// SetApplicationInfo - registers the name and version of the application using the SDK.
+// The value "<name>/<version>" is appended to the User-Agent header of every request.
+// Passing an empty name removes the application information.
+func SetApplicationInfo(name string, version string) {
+	appInfo := name
+	if name != "" && version != "" {
+		appInfo += "/" + version
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.appInfo = appInfo
+}
+
+// SetRequestIDHeaders - enables generation of a unique ID for every request.
+// The generated ID is sent in each of the named headers (e.g. HeaderNameRequestID, HeaderNameCorrelationID).
+// Calling this function without header names disables request ID generation.
+func SetRequestIDHeaders(headerNames ...string) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.requestIDHeaders = append([]string(nil), headerNames...)
+}
+
+// SetRequestIDGenerator - replaces the function used to generate request IDs.
+// By default a random UUID is generated. Passing nil restores the default.
+func SetRequestIDGenerator(generator func() string) {
+	if generator == nil {
+		generator = uuid.NewString
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.requestIDGenerator = generator
+}
+
+// SetOperationHeaders - attaches headers to every request of a single operation (e.g. "GetWorkspace").
+// Passing a nil or empty map removes the headers previously set for the operation.
+func SetOperationHeaders(operationId string, headers map[string]string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	operationHeaders := make(map[string]map[string]string, len(registry.operationHeaders)+1)
+	for id, h := range registry.operationHeaders {
+		operationHeaders[id] = h
+	}
+	if len(headers) == 0 {
+		delete(operationHeaders, operationId)
+	} else {
+		h := make(map[string]string, len(headers))
+		for headerName, headerValue := range headers {
+			h[headerName] = headerValue
+		}
+		operationHeaders[operationId] = h
+	}
+	registry.operationHeaders = operationHeaders
+}
+
+// AddHeaderProvider - registers a function that computes headers for each request.
+// Providers are invoked in registration order after all other registered headers have been applied,
+// so a provider can override them.
+func AddHeaderProvider(provider HeaderProvider) {
+	if provider == nil {
+		return
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	providers := make([]HeaderProvider, 0, len(registry.providers)+1)
+	providers = append(providers, registry.providers...)
+	registry.providers = append(providers, provider)
+}
+
+// ResetSdkHeaders - removes all application-supplied header configuration.
+func ResetSdkHeaders() {
+	fresh := newHeaderRegistry()
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.appInfo = fresh.appInfo
+	registry.requestIDHeaders = fresh.requestIDHeaders
+	registry.requestIDGenerator = fresh.requestIDGenerator
+	registry.operationHeaders = fresh.operationHeaders
+	registry.providers = fresh.providers
+}
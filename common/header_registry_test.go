@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetApplicationInfo(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	SetApplicationInfo("my-app", "1.2.3")
+	headers := GetSdkHeaders("myService", "v123", "myOperation")
+	assert.True(t, strings.HasPrefix(headers[headerNameUserAgent], GetUserAgentInfo()))
+	assert.True(t, strings.HasSuffix(headers[headerNameUserAgent], " my-app/1.2.3"))
+
+	SetApplicationInfo("", "")
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, GetUserAgentInfo(), headers[headerNameUserAgent])
+}
+
+func TestSetRequestIDHeaders(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	headers := GetSdkHeaders("myService", "v123", "myOperation")
+	_, foundIt := headers[HeaderNameRequestID]
+	assert.False(t, foundIt)
+
+	SetRequestIDHeaders(HeaderNameRequestID, HeaderNameCorrelationID)
+	first := GetSdkHeaders("myService", "v123", "myOperation")
+	second := GetSdkHeaders("myService", "v123", "myOperation")
+	assert.NotEmpty(t, first[HeaderNameRequestID])
+	assert.Equal(t, first[HeaderNameRequestID], first[HeaderNameCorrelationID])
+	assert.NotEqual(t, first[HeaderNameRequestID], second[HeaderNameRequestID])
+
+	SetRequestIDGenerator(func() string { return "fixed-id" })
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, "fixed-id", headers[HeaderNameRequestID])
+
+	SetRequestIDHeaders()
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	_, foundIt = headers[HeaderNameRequestID]
+	assert.False(t, foundIt)
+}
+
+func TestRequestIDSuppliedByCaller(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	SetRequestIDHeaders(HeaderNameRequestID, HeaderNameCorrelationID)
+	headers := GetSdkHeadersForRequest("myService", "v123", "myOperation", map[string]string{"x-request-id": "caller-id"})
+	_, foundIt := headers[HeaderNameRequestID]
+	assert.False(t, foundIt)
+	assert.Equal(t, "caller-id", headers[HeaderNameCorrelationID])
+
+	headers = GetSdkHeadersForRequest("myService", "v123", "myOperation", map[string]string{"X-Other": "value"})
+	assert.NotEmpty(t, headers[HeaderNameRequestID])
+	assert.Equal(t, headers[HeaderNameRequestID], headers[HeaderNameCorrelationID])
+}
+
+func TestSetOperationHeaders(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	SetOperationHeaders("myOperation", map[string]string{"X-Custom": "value"})
+	headers := GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, "value", headers["X-Custom"])
+
+	headers = GetSdkHeaders("myService", "v123", "otherOperation")
+	_, foundIt := headers["X-Custom"]
+	assert.False(t, foundIt)
+
+	SetOperationHeaders("myOperation", nil)
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	_, foundIt = headers["X-Custom"]
+	assert.False(t, foundIt)
+}
+
+func TestAddHeaderProvider(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	SetOperationHeaders("myOperation", map[string]string{"X-Custom": "static"})
+	AddHeaderProvider(func(serviceName string, serviceVersion string, operationId string) map[string]string {
+		return map[string]string{
+			"X-Custom":    "dynamic",
+			"X-Operation": serviceName + "/" + serviceVersion + "/" + operationId,
+		}
+	})
+
+	headers := GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, "dynamic", headers["X-Custom"])
+	assert.Equal(t, "myService/v123/myOperation", headers["X-Operation"])
+
+	ResetSdkHeaders()
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, 1, len(headers))
+}
+
+func TestSdkHeadersReplaceCallerHeadersOfAnyCase(t *testing.T) {
+	defer ResetSdkHeaders()
+
+	SetOperationHeaders("myOperation", map[string]string{"X-Custom": "static", "x-request-id": "operation-id"})
+	AddHeaderProvider(func(serviceName string, serviceVersion string, operationId string) map[string]string {
+		return map[string]string{"X-PROVIDED": "dynamic"}
+	})
+	headers := GetSdkHeadersForRequest("myService", "v123", "myOperation", map[string]string{"x-custom": "caller", "x-provided": "caller"})
+	assert.Equal(t, map[string]string{
+		"User-Agent":   GetUserAgentInfo(),
+		"x-custom":     "static",
+		"x-provided":   "dynamic",
+		"x-request-id": "operation-id",
+	}, headers)
+}
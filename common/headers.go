@@ -18,6 +18,7 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"runtime"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -54,6 +55,13 @@ const (
 // Note: It is very important that the sdk name ends with the string `-sdk`,
 // as the analytics data collector uses this to gather usage data.
 //
+// Applications can contribute to the returned headers through the registry functions in this package:
+// SetApplicationInfo appends an application name/version to the User-Agent header, SetRequestIDHeaders
+// generates a unique ID for each call, SetOperationHeaders attaches headers to a single operation and
+// AddHeaderProvider computes headers dynamically. Headers returned by this function take precedence over
+// headers with the same name supplied in an operation's Headers map, except for request ID headers: generated
+// service methods call GetSdkHeadersForRequest, which keeps a request ID supplied by the caller.
+//
 // Parameters:
 //
 //	serviceName - the name of the service as defined in the API definition (e.g. "MyService1")
@@ -64,9 +72,77 @@ const (
 //
 //	a Map which contains the set of headers to be included in the REST API request
 func GetSdkHeaders(serviceName string, serviceVersion string, operationId string) map[string]string {
+	return GetSdkHeadersForRequest(serviceName, serviceVersion, operationId, nil)
+}
+
+// GetSdkHeadersForRequest - returns the set of SDK-specific headers to be included in an outgoing request, like
+// GetSdkHeaders, given the headers that the caller supplied for the request.
+//
+// If the caller supplied one of the request ID headers enabled by SetRequestIDHeaders, no request ID is generated:
+// the header is left out of the returned headers, and the caller's value is sent in the other request ID headers.
+// Header names are compared in their canonical form (see http.CanonicalHeaderKey). A returned header that the caller
+// also supplied has the caller's spelling of the name, so that it replaces the caller's header instead of being
+// sent next to it.
+func GetSdkHeadersForRequest(serviceName string, serviceVersion string, operationId string, requestHeaders map[string]string) map[string]string {
 	sdkHeaders := make(map[string]string)
 
-	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	registry.RLock()
+	appInfo := registry.appInfo
+	requestIDHeaders := registry.requestIDHeaders
+	requestIDGenerator := registry.requestIDGenerator
+	operationHeaders := registry.operationHeaders[operationId]
+	providers := registry.providers
+	registry.RUnlock()
+
+	// names maps the canonical form of every header name to the spelling used in sdkHeaders.
+	names := make(map[string]string, len(requestHeaders))
+	for requestHeaderName := range requestHeaders {
+		names[http.CanonicalHeaderKey(requestHeaderName)] = requestHeaderName
+	}
+	setHeader := func(headerName string, headerValue string) {
+		canonicalName := http.CanonicalHeaderKey(headerName)
+		if name, ok := names[canonicalName]; ok {
+			delete(sdkHeaders, name)
+			headerName = name
+		}
+		names[canonicalName] = headerName
+		sdkHeaders[headerName] = headerValue
+	}
+
+	userAgent := GetUserAgentInfo()
+	if appInfo != "" {
+		userAgent += " " + appInfo
+	}
+	setHeader(headerNameUserAgent, userAgent)
+
+	if len(requestIDHeaders) > 0 {
+		requestID, supplied := "", map[string]bool{}
+		for _, headerName := range requestIDHeaders {
+			for requestHeaderName, requestHeaderValue := range requestHeaders {
+				if http.CanonicalHeaderKey(requestHeaderName) == http.CanonicalHeaderKey(headerName) {
+					requestID, supplied[headerName] = requestHeaderValue, true
+				}
+			}
+		}
+		if requestID == "" {
+			requestID = requestIDGenerator()
+		}
+		for _, headerName := range requestIDHeaders {
+			if !supplied[headerName] {
+				setHeader(headerName, requestID)
+			}
+		}
+	}
+
+	for headerName, headerValue := range operationHeaders {
+		setHeader(headerName, headerValue)
+	}
+
+	for _, provider := range providers {
+		for headerName, headerValue := range provider(serviceName, serviceVersion, operationId) {
+			setHeader(headerName, headerValue)
+		}
+	}
 
 	return sdkHeaders
 }
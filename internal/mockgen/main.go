@@ -0,0 +1,411 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command mockgen generates the SchematicsV1API interface and its mock implementation from the exported
+// methods of SchematicsV1. It is run by "go generate" in the schematicsv1 directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const licenseHeader = `/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+`
+
+const (
+	serviceType   = "SchematicsV1"
+	interfaceName = "SchematicsV1API"
+	packageName   = "schematicsv1"
+	packagePath   = "github.com/IBM/schematics-go-sdk/schematicsv1"
+	mockPackage   = "mocks"
+	testifyMock   = "github.com/stretchr/testify/mock"
+)
+
+// configurationMethods are the exported methods of the service type that configure the service rather than use
+// the API. Like options and model constructors, they are left out of the interface.
+var configurationMethods = map[string]bool{
+	"Clone":                    true,
+	"SetServiceURL":            true,
+	"GetServiceURL":            true,
+	"SetDefaultHeaders":        true,
+	"SetEnableGzipCompression": true,
+	"GetEnableGzipCompression": true,
+	"EnableRetries":            true,
+	"DisableRetries":           true,
+	"Use":                      true,
+	"EnableResponseCache":      true,
+	"EnableRateLimiting":       true,
+	"EnableDryRun":             true,
+}
+
+// method is an exported method of the service type.
+type method struct {
+	name     string
+	params   []param
+	results  []ast.Expr
+	variadic bool
+}
+
+type param struct {
+	name string
+	typ  ast.Expr
+}
+
+func main() {
+	dir := flag.String("dir", ".", "the directory of the schematicsv1 package")
+	flag.Parse()
+
+	methods, imports, err := parseMethods(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(*dir, "api.go"), generateInterface(methods, imports)); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(*dir, mockPackage), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := write(filepath.Join(*dir, mockPackage, "schematics_v1_api.go"), generateMock(methods, imports)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseMethods returns the exported methods of the service type, those of the generated service file first,
+// and the import paths by package name of the files declaring them.
+func parseMethods(dir string) ([]method, map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return filepath.Base(files[i]) == "schematics_v1.go" && filepath.Base(files[j]) != "schematics_v1.go"
+	})
+
+	fset := token.NewFileSet()
+	imports := map[string]string{}
+	var methods []method
+	for _, file := range files {
+		base := filepath.Base(file)
+		if strings.HasSuffix(base, "_test.go") || base == "api.go" {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, spec := range parsed.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			} else if strings.HasSuffix(path, "/v5/core") {
+				name = "core"
+			}
+			imports[name] = path
+		}
+		for _, decl := range parsed.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv == nil || !function.Name.IsExported() || !isServiceReceiver(function.Recv.List[0].Type) {
+				continue
+			}
+			if isConstructor(function) || configurationMethods[function.Name.Name] {
+				continue
+			}
+			methods = append(methods, newMethod(function))
+		}
+	}
+	return methods, imports, nil
+}
+
+func isServiceReceiver(expr ast.Expr) bool {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == serviceType
+}
+
+// isConstructor returns true for options and model constructors: methods whose receiver is unnamed, and methods
+// whose name starts with "New", such as the converters of response models to request models.
+func isConstructor(function *ast.FuncDecl) bool {
+	return len(function.Recv.List[0].Names) == 0 || strings.HasPrefix(function.Name.Name, "New")
+}
+
+func newMethod(function *ast.FuncDecl) method {
+	m := method{name: function.Name.Name}
+	for i, field := range function.Type.Params.List {
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			m.variadic = true
+			field.Type = &ast.ArrayType{Elt: ellipsis.Elt}
+		}
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("arg%d", i), typ: field.Type})
+		}
+		for _, name := range field.Names {
+			m.params = append(m.params, param{name: name.Name, typ: field.Type})
+		}
+	}
+	if function.Type.Results != nil {
+		for _, field := range function.Type.Results.List {
+			for range max(len(field.Names), 1) {
+				m.results = append(m.results, field.Type)
+			}
+		}
+	}
+	return m
+}
+
+// typeString prints a type, qualifying the exported identifiers of the service package if qualify is true.
+// The package names it refers to are added to used.
+func typeString(expr ast.Expr, qualify bool, used map[string]bool) string {
+	var rewrite func(ast.Expr) ast.Expr
+	rewrite = func(expr ast.Expr) ast.Expr {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if qualify && e.IsExported() {
+				used[packageName] = true
+				return &ast.SelectorExpr{X: ast.NewIdent(packageName), Sel: e}
+			}
+			return e
+		case *ast.SelectorExpr:
+			used[e.X.(*ast.Ident).Name] = true
+			return e
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: rewrite(e.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: e.Len, Elt: rewrite(e.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: rewrite(e.Key), Value: rewrite(e.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: rewrite(e.Elt)}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: rewriteFields(e.Params, rewrite), Results: rewriteFields(e.Results, rewrite)}
+		case *ast.IndexExpr:
+			return &ast.IndexExpr{X: rewrite(e.X), Index: rewrite(e.Index)}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: e.Dir, Value: rewrite(e.Value)}
+		}
+		return expr
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), rewrite(expr)); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func rewriteFields(fields *ast.FieldList, rewrite func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	rewritten := &ast.FieldList{}
+	for _, field := range fields.List {
+		rewritten.List = append(rewritten.List, &ast.Field{Names: field.Names, Type: rewrite(field.Type)})
+	}
+	return rewritten
+}
+
+// signature returns the parameter and result lists of a method.
+func (m method) signature(qualify bool, used map[string]bool) (params string, results string) {
+	var p []string
+	for i, param := range m.params {
+		typ := typeString(param.typ, qualify, used)
+		if m.variadic && i == len(m.params)-1 {
+			typ = "..." + strings.TrimPrefix(typ, "[]")
+		}
+		p = append(p, param.name+" "+typ)
+	}
+	var r []string
+	for _, result := range m.results {
+		r = append(r, typeString(result, qualify, used))
+	}
+	results = strings.Join(r, ", ")
+	if len(r) > 1 {
+		results = "(" + results + ")"
+	}
+	return strings.Join(p, ", "), results
+}
+
+func (m method) arguments() string {
+	var args []string
+	for i, param := range m.params {
+		if m.variadic && i == len(m.params)-1 {
+			args = append(args, param.name+"...")
+		} else {
+			args = append(args, param.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func generateInterface(methods []method, imports map[string]string) []byte {
+	used := map[string]bool{}
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// %s : the operations of the Schematics API, with and without a context, and the high-level helpers of\n", interfaceName)
+	fmt.Fprintf(&body, "// the service. It is implemented by %s; consumers can depend on it to substitute the mock\n", serviceType)
+	fmt.Fprintf(&body, "// implementation in the %s subpackage in unit tests. Options and model constructors and the methods that\n", mockPackage)
+	fmt.Fprintf(&body, "// configure the service (e.g. Clone, EnableRetries and Use) are not part of the interface.\n")
+	fmt.Fprintf(&body, "type %s interface {\n", interfaceName)
+	for _, m := range methods {
+		params, results := m.signature(false, used)
+		fmt.Fprintf(&body, "\t%s(%s) %s\n", m.name, params, results)
+	}
+	fmt.Fprintf(&body, "}\n\n")
+	fmt.Fprintf(&body, "// Verify that %s implements %s.\n", serviceType, interfaceName)
+	fmt.Fprintf(&body, "var _ %s = (*%s)(nil)\n", interfaceName, serviceType)
+
+	return source(packageName, used, imports, body.Bytes())
+}
+
+func generateMock(methods []method, imports map[string]string) []byte {
+	used := map[string]bool{packageName: true}
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// %s : a mock implementation of %s.%s.\n", interfaceName, packageName, interfaceName)
+	fmt.Fprintf(&body, "//\n")
+	fmt.Fprintf(&body, "// Script expectations with On and Return (see github.com/stretchr/testify/mock). Return accepts either the\n")
+	fmt.Fprintf(&body, "// method's return values or a function with the method's signature that computes them from the arguments.\n")
+	fmt.Fprintf(&body, "type %s struct {\n\tmock.Mock\n}\n\n", interfaceName)
+	fmt.Fprintf(&body, "// New%s : constructs a %s whose expectations are asserted when the test completes.\n", interfaceName, interfaceName)
+	fmt.Fprintf(&body, "func New%s(t interface {\n\tmock.TestingT\n\tCleanup(func())\n}) *%s {\n", interfaceName, interfaceName)
+	fmt.Fprintf(&body, "\tm := &%s{}\n\tm.Mock.Test(t)\n\tt.Cleanup(func() { m.AssertExpectations(t) })\n\treturn m\n}\n\n", interfaceName)
+	fmt.Fprintf(&body, "// Verify that %s implements %s.%s.\n", interfaceName, packageName, interfaceName)
+	fmt.Fprintf(&body, "var _ %s.%s = (*%s)(nil)\n", packageName, interfaceName, interfaceName)
+
+	for _, m := range methods {
+		params, results := m.signature(true, used)
+		fmt.Fprintf(&body, "\n// %s provides a mock function for %s.%s.\n", m.name, interfaceName, m.name)
+		fmt.Fprintf(&body, "func (_m *%s) %s(%s) %s {\n", interfaceName, m.name, params, results)
+		var args []string
+		for _, param := range m.params {
+			args = append(args, param.name)
+		}
+		if len(m.results) == 0 {
+			fmt.Fprintf(&body, "\t_m.Called(%s)\n}\n", strings.Join(args, ", "))
+			continue
+		}
+
+		fmt.Fprintf(&body, "\tret := _m.Called(%s)\n", strings.Join(args, ", "))
+		fmt.Fprintf(&body, "\tif len(ret) == 0 {\n\t\tpanic(\"no return value specified for %s\")\n\t}\n", m.name)
+		fmt.Fprintf(&body, "\tif rf, ok := ret.Get(0).(func(%s) %s); ok {\n\t\treturn rf(%s)\n\t}\n", params, results, m.arguments())
+		var values []string
+		for i, result := range m.results {
+			typ := typeString(result, true, used)
+			value := fmt.Sprintf("r%d", i)
+			if typ == "error" {
+				fmt.Fprintf(&body, "\t%s := ret.Error(%d)\n", value, i)
+			} else {
+				fmt.Fprintf(&body, "\t%s, _ := ret.Get(%d).(%s)\n", value, i, typ)
+			}
+			values = append(values, value)
+		}
+		fmt.Fprintf(&body, "\treturn %s\n}\n", strings.Join(values, ", "))
+	}
+
+	imports = copyImports(imports)
+	imports["mock"] = testifyMock
+	imports[packageName] = packagePath
+	used["mock"] = true
+	return source(mockPackage, used, imports, body.Bytes())
+}
+
+func copyImports(imports map[string]string) map[string]string {
+	copied := make(map[string]string, len(imports))
+	for name, path := range imports {
+		copied[name] = path
+	}
+	return copied
+}
+
+// source assembles and formats a generated file.
+func source(pkg string, used map[string]bool, imports map[string]string, body []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(licenseHeader)
+	fmt.Fprintf(&buf, "\npackage %s\n\nimport (\n", pkg)
+	var names []string
+	for name := range used {
+		if name != pkg {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return imports[names[i]] < imports[names[j]] })
+	for _, standard := range []bool{true, false} {
+		if !standard {
+			buf.WriteString("\n")
+		}
+		for _, name := range names {
+			path, ok := imports[name]
+			if !ok {
+				log.Fatalf("unknown package %s", name)
+			}
+			if isStandard(path) != standard {
+				continue
+			}
+			if path[strings.LastIndex(path, "/")+1:] == name {
+				fmt.Fprintf(&buf, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+			}
+		}
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body)
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%v\n%s", err, buf.Bytes())
+	}
+	return formatted
+}
+
+// isStandard returns true for the import paths of standard library packages.
+func isStandard(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+func write(path string, content []byte) error {
+	return os.WriteFile(path, content, 0644)
+}
@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package schematicsv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SchematicsV1API : the operations of the Schematics API, with and without a context, and the high-level helpers of
+// the service. It is implemented by SchematicsV1; consumers can depend on it to substitute the mock
+// implementation in the mocks subpackage in unit tests. Options and model constructors and the methods that
+// configure the service (e.g. Clone, EnableRetries and Use) are not part of the interface.
+type SchematicsV1API interface {
+	ListSchematicsLocation(listSchematicsLocationOptions *ListSchematicsLocationOptions) ([]SchematicsLocations, *core.DetailedResponse, error)
+	ListSchematicsLocationWithContext(ctx context.Context, listSchematicsLocationOptions *ListSchematicsLocationOptions) ([]SchematicsLocations, *core.DetailedResponse, error)
+	ListLocations(listLocationsOptions *ListLocationsOptions) (*SchematicsLocationsList, *core.DetailedResponse, error)
+	ListLocationsWithContext(ctx context.Context, listLocationsOptions *ListLocationsOptions) (*SchematicsLocationsList, *core.DetailedResponse, error)
+	ListResourceGroup(listResourceGroupOptions *ListResourceGroupOptions) ([]ResourceGroupResponse, *core.DetailedResponse, error)
+	ListResourceGroupWithContext(ctx context.Context, listResourceGroupOptions *ListResourceGroupOptions) ([]ResourceGroupResponse, *core.DetailedResponse, error)
+	GetSchematicsVersion(getSchematicsVersionOptions *GetSchematicsVersionOptions) (*VersionResponse, *core.DetailedResponse, error)
+	GetSchematicsVersionWithContext(ctx context.Context, getSchematicsVersionOptions *GetSchematicsVersionOptions) (*VersionResponse, *core.DetailedResponse, error)
+	ProcessTemplateMetaData(processTemplateMetaDataOptions *ProcessTemplateMetaDataOptions) (*TemplateMetaDataResponse, *core.DetailedResponse, error)
+	ProcessTemplateMetaDataWithContext(ctx context.Context, processTemplateMetaDataOptions *ProcessTemplateMetaDataOptions) (*TemplateMetaDataResponse, *core.DetailedResponse, error)
+	ListWorkspaces(listWorkspacesOptions *ListWorkspacesOptions) (*WorkspaceResponseList, *core.DetailedResponse, error)
+	ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (*WorkspaceResponseList, *core.DetailedResponse, error)
+	CreateWorkspace(createWorkspaceOptions *CreateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	GetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	ReplaceWorkspace(replaceWorkspaceOptions *ReplaceWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	ReplaceWorkspaceWithContext(ctx context.Context, replaceWorkspaceOptions *ReplaceWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	DeleteWorkspace(deleteWorkspaceOptions *DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error)
+	DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error)
+	UpdateWorkspace(updateWorkspaceOptions *UpdateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error)
+	GetWorkspaceReadme(getWorkspaceReadmeOptions *GetWorkspaceReadmeOptions) (*TemplateReadme, *core.DetailedResponse, error)
+	GetWorkspaceReadmeWithContext(ctx context.Context, getWorkspaceReadmeOptions *GetWorkspaceReadmeOptions) (*TemplateReadme, *core.DetailedResponse, error)
+	TemplateRepoUpload(templateRepoUploadOptions *TemplateRepoUploadOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	TemplateRepoUploadWithContext(ctx context.Context, templateRepoUploadOptions *TemplateRepoUploadOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	GetWorkspaceInputs(getWorkspaceInputsOptions *GetWorkspaceInputsOptions) (*TemplateValues, *core.DetailedResponse, error)
+	GetWorkspaceInputsWithContext(ctx context.Context, getWorkspaceInputsOptions *GetWorkspaceInputsOptions) (*TemplateValues, *core.DetailedResponse, error)
+	ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions *ReplaceWorkspaceInputsOptions) (*UserValues, *core.DetailedResponse, error)
+	ReplaceWorkspaceInputsWithContext(ctx context.Context, replaceWorkspaceInputsOptions *ReplaceWorkspaceInputsOptions) (*UserValues, *core.DetailedResponse, error)
+	GetAllWorkspaceInputs(getAllWorkspaceInputsOptions *GetAllWorkspaceInputsOptions) (*WorkspaceTemplateValuesResponse, *core.DetailedResponse, error)
+	GetAllWorkspaceInputsWithContext(ctx context.Context, getAllWorkspaceInputsOptions *GetAllWorkspaceInputsOptions) (*WorkspaceTemplateValuesResponse, *core.DetailedResponse, error)
+	GetWorkspaceInputMetadata(getWorkspaceInputMetadataOptions *GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error)
+	GetWorkspaceInputMetadataWithContext(ctx context.Context, getWorkspaceInputMetadataOptions *GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error)
+	GetWorkspaceOutputs(getWorkspaceOutputsOptions *GetWorkspaceOutputsOptions) ([]OutputValuesInner, *core.DetailedResponse, error)
+	GetWorkspaceOutputsWithContext(ctx context.Context, getWorkspaceOutputsOptions *GetWorkspaceOutputsOptions) ([]OutputValuesInner, *core.DetailedResponse, error)
+	GetWorkspaceResources(getWorkspaceResourcesOptions *GetWorkspaceResourcesOptions) ([]TemplateResources, *core.DetailedResponse, error)
+	GetWorkspaceResourcesWithContext(ctx context.Context, getWorkspaceResourcesOptions *GetWorkspaceResourcesOptions) ([]TemplateResources, *core.DetailedResponse, error)
+	GetWorkspaceState(getWorkspaceStateOptions *GetWorkspaceStateOptions) (*StateStoreResponseList, *core.DetailedResponse, error)
+	GetWorkspaceStateWithContext(ctx context.Context, getWorkspaceStateOptions *GetWorkspaceStateOptions) (*StateStoreResponseList, *core.DetailedResponse, error)
+	GetWorkspaceTemplateState(getWorkspaceTemplateStateOptions *GetWorkspaceTemplateStateOptions) (*TemplateStateStore, *core.DetailedResponse, error)
+	GetWorkspaceTemplateStateWithContext(ctx context.Context, getWorkspaceTemplateStateOptions *GetWorkspaceTemplateStateOptions) (*TemplateStateStore, *core.DetailedResponse, error)
+	GetWorkspaceActivityLogs(getWorkspaceActivityLogsOptions *GetWorkspaceActivityLogsOptions) (*WorkspaceActivityLogs, *core.DetailedResponse, error)
+	GetWorkspaceActivityLogsWithContext(ctx context.Context, getWorkspaceActivityLogsOptions *GetWorkspaceActivityLogsOptions) (*WorkspaceActivityLogs, *core.DetailedResponse, error)
+	GetWorkspaceLogUrls(getWorkspaceLogUrlsOptions *GetWorkspaceLogUrlsOptions) (*LogStoreResponseList, *core.DetailedResponse, error)
+	GetWorkspaceLogUrlsWithContext(ctx context.Context, getWorkspaceLogUrlsOptions *GetWorkspaceLogUrlsOptions) (*LogStoreResponseList, *core.DetailedResponse, error)
+	GetTemplateLogs(getTemplateLogsOptions *GetTemplateLogsOptions) (*string, *core.DetailedResponse, error)
+	GetTemplateLogsWithContext(ctx context.Context, getTemplateLogsOptions *GetTemplateLogsOptions) (*string, *core.DetailedResponse, error)
+	GetTemplateActivityLog(getTemplateActivityLogOptions *GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error)
+	GetTemplateActivityLogWithContext(ctx context.Context, getTemplateActivityLogOptions *GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error)
+	ListActions(listActionsOptions *ListActionsOptions) (*ActionList, *core.DetailedResponse, error)
+	ListActionsWithContext(ctx context.Context, listActionsOptions *ListActionsOptions) (*ActionList, *core.DetailedResponse, error)
+	CreateAction(createActionOptions *CreateActionOptions) (*Action, *core.DetailedResponse, error)
+	CreateActionWithContext(ctx context.Context, createActionOptions *CreateActionOptions) (*Action, *core.DetailedResponse, error)
+	GetAction(getActionOptions *GetActionOptions) (*Action, *core.DetailedResponse, error)
+	GetActionWithContext(ctx context.Context, getActionOptions *GetActionOptions) (*Action, *core.DetailedResponse, error)
+	DeleteAction(deleteActionOptions *DeleteActionOptions) (*core.DetailedResponse, error)
+	DeleteActionWithContext(ctx context.Context, deleteActionOptions *DeleteActionOptions) (*core.DetailedResponse, error)
+	UpdateAction(updateActionOptions *UpdateActionOptions) (*Action, *core.DetailedResponse, error)
+	UpdateActionWithContext(ctx context.Context, updateActionOptions *UpdateActionOptions) (*Action, *core.DetailedResponse, error)
+	UploadTemplateTarAction(uploadTemplateTarActionOptions *UploadTemplateTarActionOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	UploadTemplateTarActionWithContext(ctx context.Context, uploadTemplateTarActionOptions *UploadTemplateTarActionOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	ListWorkspaceActivities(listWorkspaceActivitiesOptions *ListWorkspaceActivitiesOptions) (*WorkspaceActivities, *core.DetailedResponse, error)
+	ListWorkspaceActivitiesWithContext(ctx context.Context, listWorkspaceActivitiesOptions *ListWorkspaceActivitiesOptions) (*WorkspaceActivities, *core.DetailedResponse, error)
+	GetWorkspaceActivity(getWorkspaceActivityOptions *GetWorkspaceActivityOptions) (*WorkspaceActivity, *core.DetailedResponse, error)
+	GetWorkspaceActivityWithContext(ctx context.Context, getWorkspaceActivityOptions *GetWorkspaceActivityOptions) (*WorkspaceActivity, *core.DetailedResponse, error)
+	DeleteWorkspaceActivity(deleteWorkspaceActivityOptions *DeleteWorkspaceActivityOptions) (*WorkspaceActivityApplyResult, *core.DetailedResponse, error)
+	DeleteWorkspaceActivityWithContext(ctx context.Context, deleteWorkspaceActivityOptions *DeleteWorkspaceActivityOptions) (*WorkspaceActivityApplyResult, *core.DetailedResponse, error)
+	RunWorkspaceCommands(runWorkspaceCommandsOptions *RunWorkspaceCommandsOptions) (*WorkspaceActivityCommandResult, *core.DetailedResponse, error)
+	RunWorkspaceCommandsWithContext(ctx context.Context, runWorkspaceCommandsOptions *RunWorkspaceCommandsOptions) (*WorkspaceActivityCommandResult, *core.DetailedResponse, error)
+	ApplyWorkspaceCommand(applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (*WorkspaceActivityApplyResult, *core.DetailedResponse, error)
+	ApplyWorkspaceCommandWithContext(ctx context.Context, applyWorkspaceCommandOptions *ApplyWorkspaceCommandOptions) (*WorkspaceActivityApplyResult, *core.DetailedResponse, error)
+	DestroyWorkspaceCommand(destroyWorkspaceCommandOptions *DestroyWorkspaceCommandOptions) (*WorkspaceActivityDestroyResult, *core.DetailedResponse, error)
+	DestroyWorkspaceCommandWithContext(ctx context.Context, destroyWorkspaceCommandOptions *DestroyWorkspaceCommandOptions) (*WorkspaceActivityDestroyResult, *core.DetailedResponse, error)
+	PlanWorkspaceCommand(planWorkspaceCommandOptions *PlanWorkspaceCommandOptions) (*WorkspaceActivityPlanResult, *core.DetailedResponse, error)
+	PlanWorkspaceCommandWithContext(ctx context.Context, planWorkspaceCommandOptions *PlanWorkspaceCommandOptions) (*WorkspaceActivityPlanResult, *core.DetailedResponse, error)
+	RefreshWorkspaceCommand(refreshWorkspaceCommandOptions *RefreshWorkspaceCommandOptions) (*WorkspaceActivityRefreshResult, *core.DetailedResponse, error)
+	RefreshWorkspaceCommandWithContext(ctx context.Context, refreshWorkspaceCommandOptions *RefreshWorkspaceCommandOptions) (*WorkspaceActivityRefreshResult, *core.DetailedResponse, error)
+	ListJobs(listJobsOptions *ListJobsOptions) (*JobList, *core.DetailedResponse, error)
+	ListJobsWithContext(ctx context.Context, listJobsOptions *ListJobsOptions) (*JobList, *core.DetailedResponse, error)
+	CreateJob(createJobOptions *CreateJobOptions) (*Job, *core.DetailedResponse, error)
+	CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (*Job, *core.DetailedResponse, error)
+	GetJob(getJobOptions *GetJobOptions) (*Job, *core.DetailedResponse, error)
+	GetJobWithContext(ctx context.Context, getJobOptions *GetJobOptions) (*Job, *core.DetailedResponse, error)
+	UpdateJob(updateJobOptions *UpdateJobOptions) (*Job, *core.DetailedResponse, error)
+	UpdateJobWithContext(ctx context.Context, updateJobOptions *UpdateJobOptions) (*Job, *core.DetailedResponse, error)
+	DeleteJob(deleteJobOptions *DeleteJobOptions) (*core.DetailedResponse, error)
+	DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (*core.DetailedResponse, error)
+	ListJobLogs(listJobLogsOptions *ListJobLogsOptions) (*JobLog, *core.DetailedResponse, error)
+	ListJobLogsWithContext(ctx context.Context, listJobLogsOptions *ListJobLogsOptions) (*JobLog, *core.DetailedResponse, error)
+	GetJobFiles(getJobFilesOptions *GetJobFilesOptions) (*JobFileData, *core.DetailedResponse, error)
+	GetJobFilesWithContext(ctx context.Context, getJobFilesOptions *GetJobFilesOptions) (*JobFileData, *core.DetailedResponse, error)
+	CreateWorkspaceDeletionJob(createWorkspaceDeletionJobOptions *CreateWorkspaceDeletionJobOptions) (*WorkspaceBulkDeleteResponse, *core.DetailedResponse, error)
+	CreateWorkspaceDeletionJobWithContext(ctx context.Context, createWorkspaceDeletionJobOptions *CreateWorkspaceDeletionJobOptions) (*WorkspaceBulkDeleteResponse, *core.DetailedResponse, error)
+	GetWorkspaceDeletionJobStatus(getWorkspaceDeletionJobStatusOptions *GetWorkspaceDeletionJobStatusOptions) (*WorkspaceJobResponse, *core.DetailedResponse, error)
+	GetWorkspaceDeletionJobStatusWithContext(ctx context.Context, getWorkspaceDeletionJobStatusOptions *GetWorkspaceDeletionJobStatusOptions) (*WorkspaceJobResponse, *core.DetailedResponse, error)
+	ListInventories(listInventoriesOptions *ListInventoriesOptions) (*InventoryResourceRecordList, *core.DetailedResponse, error)
+	ListInventoriesWithContext(ctx context.Context, listInventoriesOptions *ListInventoriesOptions) (*InventoryResourceRecordList, *core.DetailedResponse, error)
+	CreateInventory(createInventoryOptions *CreateInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	CreateInventoryWithContext(ctx context.Context, createInventoryOptions *CreateInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	GetInventory(getInventoryOptions *GetInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	GetInventoryWithContext(ctx context.Context, getInventoryOptions *GetInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	ReplaceInventory(replaceInventoryOptions *ReplaceInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	ReplaceInventoryWithContext(ctx context.Context, replaceInventoryOptions *ReplaceInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error)
+	DeleteInventory(deleteInventoryOptions *DeleteInventoryOptions) (*core.DetailedResponse, error)
+	DeleteInventoryWithContext(ctx context.Context, deleteInventoryOptions *DeleteInventoryOptions) (*core.DetailedResponse, error)
+	ListResourceQuery(listResourceQueryOptions *ListResourceQueryOptions) (*ResourceQueryRecordList, *core.DetailedResponse, error)
+	ListResourceQueryWithContext(ctx context.Context, listResourceQueryOptions *ListResourceQueryOptions) (*ResourceQueryRecordList, *core.DetailedResponse, error)
+	CreateResourceQuery(createResourceQueryOptions *CreateResourceQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	CreateResourceQueryWithContext(ctx context.Context, createResourceQueryOptions *CreateResourceQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	GetResourcesQuery(getResourcesQueryOptions *GetResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	GetResourcesQueryWithContext(ctx context.Context, getResourcesQueryOptions *GetResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	ReplaceResourcesQuery(replaceResourcesQueryOptions *ReplaceResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	ReplaceResourcesQueryWithContext(ctx context.Context, replaceResourcesQueryOptions *ReplaceResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error)
+	ExecuteResourceQuery(executeResourceQueryOptions *ExecuteResourceQueryOptions) (*ResourceQueryResponseRecord, *core.DetailedResponse, error)
+	ExecuteResourceQueryWithContext(ctx context.Context, executeResourceQueryOptions *ExecuteResourceQueryOptions) (*ResourceQueryResponseRecord, *core.DetailedResponse, error)
+	DeleteResourcesQuery(deleteResourcesQueryOptions *DeleteResourcesQueryOptions) (*core.DetailedResponse, error)
+	DeleteResourcesQueryWithContext(ctx context.Context, deleteResourcesQueryOptions *DeleteResourcesQueryOptions) (*core.DetailedResponse, error)
+	ListAgent(listAgentOptions *ListAgentOptions) (*AgentList, *core.DetailedResponse, error)
+	ListAgentWithContext(ctx context.Context, listAgentOptions *ListAgentOptions) (*AgentList, *core.DetailedResponse, error)
+	RegisterAgent(registerAgentOptions *RegisterAgentOptions) (*Agent, *core.DetailedResponse, error)
+	RegisterAgentWithContext(ctx context.Context, registerAgentOptions *RegisterAgentOptions) (*Agent, *core.DetailedResponse, error)
+	GetAgent(getAgentOptions *GetAgentOptions) (*Agent, *core.DetailedResponse, error)
+	GetAgentWithContext(ctx context.Context, getAgentOptions *GetAgentOptions) (*Agent, *core.DetailedResponse, error)
+	DeleteAgent(deleteAgentOptions *DeleteAgentOptions) (*core.DetailedResponse, error)
+	DeleteAgentWithContext(ctx context.Context, deleteAgentOptions *DeleteAgentOptions) (*core.DetailedResponse, error)
+	UpdateAgentRegistration(updateAgentRegistrationOptions *UpdateAgentRegistrationOptions) (*Agent, *core.DetailedResponse, error)
+	UpdateAgentRegistrationWithContext(ctx context.Context, updateAgentRegistrationOptions *UpdateAgentRegistrationOptions) (*Agent, *core.DetailedResponse, error)
+	ListAgentData(listAgentDataOptions *ListAgentDataOptions) (*AgentDataList, *core.DetailedResponse, error)
+	ListAgentDataWithContext(ctx context.Context, listAgentDataOptions *ListAgentDataOptions) (*AgentDataList, *core.DetailedResponse, error)
+	CreateAgentData(createAgentDataOptions *CreateAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	CreateAgentDataWithContext(ctx context.Context, createAgentDataOptions *CreateAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	GetAgentData(getAgentDataOptions *GetAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	GetAgentDataWithContext(ctx context.Context, getAgentDataOptions *GetAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	UpdateAgentData(updateAgentDataOptions *UpdateAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	UpdateAgentDataWithContext(ctx context.Context, updateAgentDataOptions *UpdateAgentDataOptions) (*AgentData, *core.DetailedResponse, error)
+	DeleteAgentData(deleteAgentDataOptions *DeleteAgentDataOptions) (*core.DetailedResponse, error)
+	DeleteAgentDataWithContext(ctx context.Context, deleteAgentDataOptions *DeleteAgentDataOptions) (*core.DetailedResponse, error)
+	GetAgentVersions(getAgentVersionsOptions *GetAgentVersionsOptions) (*AgentVersions, *core.DetailedResponse, error)
+	GetAgentVersionsWithContext(ctx context.Context, getAgentVersionsOptions *GetAgentVersionsOptions) (*AgentVersions, *core.DetailedResponse, error)
+	GetPrsAgentJob(getPrsAgentJobOptions *GetPrsAgentJobOptions) (*AgentPRSJob, *core.DetailedResponse, error)
+	GetPrsAgentJobWithContext(ctx context.Context, getPrsAgentJobOptions *GetPrsAgentJobOptions) (*AgentPRSJob, *core.DetailedResponse, error)
+	PrsAgentJob(prsAgentJobOptions *PrsAgentJobOptions) (*AgentPRSJob, *core.DetailedResponse, error)
+	PrsAgentJobWithContext(ctx context.Context, prsAgentJobOptions *PrsAgentJobOptions) (*AgentPRSJob, *core.DetailedResponse, error)
+	GetHealthCheckAgentJob(getHealthCheckAgentJobOptions *GetHealthCheckAgentJobOptions) (*AgentHealthJob, *core.DetailedResponse, error)
+	GetHealthCheckAgentJobWithContext(ctx context.Context, getHealthCheckAgentJobOptions *GetHealthCheckAgentJobOptions) (*AgentHealthJob, *core.DetailedResponse, error)
+	HealthCheckAgentJob(healthCheckAgentJobOptions *HealthCheckAgentJobOptions) (*AgentHealthJob, *core.DetailedResponse, error)
+	HealthCheckAgentJobWithContext(ctx context.Context, healthCheckAgentJobOptions *HealthCheckAgentJobOptions) (*AgentHealthJob, *core.DetailedResponse, error)
+	GetDeployAgentJob(getDeployAgentJobOptions *GetDeployAgentJobOptions) (*AgentDeployJob, *core.DetailedResponse, error)
+	GetDeployAgentJobWithContext(ctx context.Context, getDeployAgentJobOptions *GetDeployAgentJobOptions) (*AgentDeployJob, *core.DetailedResponse, error)
+	DeployAgentJob(deployAgentJobOptions *DeployAgentJobOptions) (*AgentDeployJob, *core.DetailedResponse, error)
+	DeployAgentJobWithContext(ctx context.Context, deployAgentJobOptions *DeployAgentJobOptions) (*AgentDeployJob, *core.DetailedResponse, error)
+	DeleteAgentResources(deleteAgentResourcesOptions *DeleteAgentResourcesOptions) (*core.DetailedResponse, error)
+	DeleteAgentResourcesWithContext(ctx context.Context, deleteAgentResourcesOptions *DeleteAgentResourcesOptions) (*core.DetailedResponse, error)
+	GetKmsSettings(getKmsSettingsOptions *GetKmsSettingsOptions) (*KMSSettings, *core.DetailedResponse, error)
+	GetKmsSettingsWithContext(ctx context.Context, getKmsSettingsOptions *GetKmsSettingsOptions) (*KMSSettings, *core.DetailedResponse, error)
+	UpdateKmsSettings(updateKmsSettingsOptions *UpdateKmsSettingsOptions) (*KMSSettings, *core.DetailedResponse, error)
+	UpdateKmsSettingsWithContext(ctx context.Context, updateKmsSettingsOptions *UpdateKmsSettingsOptions) (*KMSSettings, *core.DetailedResponse, error)
+	ListKms(listKmsOptions *ListKmsOptions) (*KMSDiscovery, *core.DetailedResponse, error)
+	ListKmsWithContext(ctx context.Context, listKmsOptions *ListKmsOptions) (*KMSDiscovery, *core.DetailedResponse, error)
+	ListPolicy(listPolicyOptions *ListPolicyOptions) (*PolicyList, *core.DetailedResponse, error)
+	ListPolicyWithContext(ctx context.Context, listPolicyOptions *ListPolicyOptions) (*PolicyList, *core.DetailedResponse, error)
+	CreatePolicy(createPolicyOptions *CreatePolicyOptions) (*Policy, *core.DetailedResponse, error)
+	CreatePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (*Policy, *core.DetailedResponse, error)
+	GetPolicy(getPolicyOptions *GetPolicyOptions) (*Policy, *core.DetailedResponse, error)
+	GetPolicyWithContext(ctx context.Context, getPolicyOptions *GetPolicyOptions) (*Policy, *core.DetailedResponse, error)
+	DeletePolicy(deletePolicyOptions *DeletePolicyOptions) (*core.DetailedResponse, error)
+	DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *DeletePolicyOptions) (*core.DetailedResponse, error)
+	UpdatePolicy(updatePolicyOptions *UpdatePolicyOptions) (*Policy, *core.DetailedResponse, error)
+	UpdatePolicyWithContext(ctx context.Context, updatePolicyOptions *UpdatePolicyOptions) (*Policy, *core.DetailedResponse, error)
+	ResolveInventory(ctx context.Context, inventoryID string) (*DynamicInventory, error)
+	ResolveInventoryRecord(ctx context.Context, record *InventoryResourceRecord) (*DynamicInventory, error)
+	EnsureWorkspace(createWorkspaceOptions *CreateWorkspaceOptions, ensureOptions *EnsureOptions) (*WorkspaceResponse, EnsureOutcome, error)
+	EnsureWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions, ensureOptions *EnsureOptions) (*WorkspaceResponse, EnsureOutcome, error)
+	EnsureAction(createActionOptions *CreateActionOptions, ensureOptions *EnsureOptions) (*Action, EnsureOutcome, error)
+	EnsureActionWithContext(ctx context.Context, createActionOptions *CreateActionOptions, ensureOptions *EnsureOptions) (*Action, EnsureOutcome, error)
+	EnsureInventory(createInventoryOptions *CreateInventoryOptions, ensureOptions *EnsureOptions) (*InventoryResourceRecord, EnsureOutcome, error)
+	EnsureInventoryWithContext(ctx context.Context, createInventoryOptions *CreateInventoryOptions, ensureOptions *EnsureOptions) (*InventoryResourceRecord, EnsureOutcome, error)
+	EnsureResourceQuery(createResourceQueryOptions *CreateResourceQueryOptions, ensureOptions *EnsureOptions) (*ResourceQueryRecord, EnsureOutcome, error)
+	EnsureResourceQueryWithContext(ctx context.Context, createResourceQueryOptions *CreateResourceQueryOptions, ensureOptions *EnsureOptions) (*ResourceQueryRecord, EnsureOutcome, error)
+	EnsureAgentData(createAgentDataOptions *CreateAgentDataOptions, ensureOptions *EnsureOptions) (*AgentData, EnsureOutcome, error)
+	EnsureAgentDataWithContext(ctx context.Context, createAgentDataOptions *CreateAgentDataOptions, ensureOptions *EnsureOptions) (*AgentData, EnsureOutcome, error)
+	EnsurePolicy(createPolicyOptions *CreatePolicyOptions, ensureOptions *EnsureOptions) (*Policy, EnsureOutcome, error)
+	EnsurePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions, ensureOptions *EnsureOptions) (*Policy, EnsureOutcome, error)
+	DownloadJobArtifacts(ctx context.Context, jobID string, dir string, options *DownloadJobArtifactsOptions) (*JobArtifactsManifest, error)
+	ValidateLocation(location string) error
+	ValidateWorkspaceLocation(workspace *WorkspaceResponse) error
+	Workspaces(options *ResourceOptions) Resource[WorkspaceResponse]
+	Actions(options *ResourceOptions) Resource[Action]
+	Inventories(options *ResourceOptions) Resource[InventoryResourceRecord]
+	ResourceQueries(options *ResourceOptions) Resource[ResourceQueryRecord]
+	Agents(options *ResourceOptions) Resource[AgentData]
+	Policies(options *ResourceOptions) Resource[Policy]
+	ExecuteResourceQueryHosts(ctx context.Context, queryID string) (ResourceQueryHosts, error)
+	RunAction(ctx context.Context, actionID string, playbook string, runActionOptions *RunActionOptions) (*RunActionResult, error)
+	UploadWorkspaceTemplateFromDir(ctx context.Context, wID string, tID string, dir string) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	UploadActionTemplateFromDir(ctx context.Context, actionID string, dir string) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	TemplateRepoUploadWithProgress(ctx context.Context, templateRepoUploadOptions *TemplateRepoUploadOptions, uploadOptions *UploadOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	UploadTemplateTarActionWithProgress(ctx context.Context, uploadTemplateTarActionOptions *UploadTemplateTarActionOptions, uploadOptions *UploadOptions) (*TemplateRepoTarUploadResponse, *core.DetailedResponse, error)
+	ExportWorkspace(ctx context.Context, wID string, writer io.Writer, options *WorkspaceExportOptions) (*WorkspaceArchiveManifest, error)
+	ImportWorkspace(ctx context.Context, reader io.Reader, options *WorkspaceImportOptions) (*WorkspaceImportResult, error)
+	CloneWorkspace(ctx context.Context, srcID string, options *WorkspaceCloneOptions) (*WorkspaceCloneResult, error)
+}
+
+// Verify that SchematicsV1 implements SchematicsV1API.
+var _ SchematicsV1API = (*SchematicsV1)(nil)
@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// ResponseCacheOptions : the configuration of a ResponseCache.
+type ResponseCacheOptions struct {
+	// The time-to-live of cached responses of GET operations that have no entry in TTLs.
+	// 0 means such operations are not cached.
+	DefaultTTL time.Duration
+
+	// The time-to-live of cached responses by operation ID (e.g. "GetWorkspace").
+	// An entry of 0 disables caching of the operation.
+	TTLs map[string]time.Duration
+
+	// The maximum number of cached responses. When the cache is full, the entry closest to
+	// expiry is evicted. 0 means unlimited.
+	MaxEntries int
+}
+
+// CacheStats : counters describing the activity of a ResponseCache.
+type CacheStats struct {
+	// Operations answered from the cache.
+	Hits int64
+
+	// Cacheable operations that were sent to the service.
+	Misses int64
+
+	// Operations that waited for an identical in-flight operation instead of being sent to the service.
+	Coalesced int64
+
+	// Invalidations caused by mutating operations or Purge.
+	Invalidations int64
+
+	// Responses currently held in the cache.
+	Entries int
+}
+
+// ResponseCache : an in-memory cache of the responses of read operations.
+//
+// Responses of successful GET operations are cached for their operation's TTL, keyed by operation ID,
+// result type and request URL. Identical operations issued while one is in flight wait for its response instead of
+// being sent to the service. Mutating operations invalidate the cached responses of the resource
+// collection they change (e.g. any workspace operation invalidates all cached workspace responses),
+// and job operations invalidate the whole cache because jobs change the workspaces and actions they run on.
+//
+// Every caller gets its own deep copy of a cached result, so callers may modify the results they get.
+// A ResponseCache is safe for concurrent use.
+type ResponseCache struct {
+	options ResponseCacheOptions
+
+	mutex      sync.Mutex
+	entries    map[string]*cacheEntry
+	calls      map[string]*cacheCall
+	generation uint64
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	coalesced     atomic.Int64
+	invalidations atomic.Int64
+}
+
+type cacheEntry struct {
+	path     string
+	response *core.DetailedResponse
+	expires  time.Time
+}
+
+// cacheCall is an in-flight operation that identical operations wait for.
+type cacheCall struct {
+	done     chan struct{}
+	ctx      context.Context
+	response *core.DetailedResponse
+	err      error
+}
+
+// NewResponseCache : constructs a ResponseCache with the specified options.
+func NewResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	return &ResponseCache{
+		options: *options,
+		entries: make(map[string]*cacheEntry),
+		calls:   make(map[string]*cacheCall),
+	}
+}
+
+// EnableResponseCache adds a ResponseCache with the specified options to the middleware chain of this
+// service instance and returns it.
+func (schematics *SchematicsV1) EnableResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	cache := NewResponseCache(options)
+	schematics.Use(cache.Middleware())
+	return cache
+}
+
+// Middleware returns a Middleware that serves cacheable operations from the cache.
+func (cache *ResponseCache) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, operation *Operation) (*core.DetailedResponse, error) {
+			if operation.Request.Method != http.MethodGet {
+				if !operation.IsReadOnly() {
+					defer cache.invalidate(operation.Request.URL.Path)
+				}
+				return next(ctx, operation)
+			}
+
+			ttl := cache.ttl(operation.ID)
+			if ttl <= 0 {
+				return next(ctx, operation)
+			}
+			return cache.get(ctx, operation, ttl, next)
+		}
+	}
+}
+
+// Stats returns the current cache statistics.
+func (cache *ResponseCache) Stats() CacheStats {
+	cache.mutex.Lock()
+	entries := len(cache.entries)
+	cache.mutex.Unlock()
+
+	return CacheStats{
+		Hits:          cache.hits.Load(),
+		Misses:        cache.misses.Load(),
+		Coalesced:     cache.coalesced.Load(),
+		Invalidations: cache.invalidations.Load(),
+		Entries:       entries,
+	}
+}
+
+// Purge removes all cached responses.
+func (cache *ResponseCache) Purge() {
+	cache.invalidate("")
+}
+
+func (cache *ResponseCache) ttl(operationID string) time.Duration {
+	if ttl, ok := cache.options.TTLs[operationID]; ok {
+		return ttl
+	}
+	return cache.options.DefaultTTL
+}
+
+// get returns the cached response for an operation, waits for an identical in-flight operation,
+// or sends the operation and caches its response.
+func (cache *ResponseCache) get(ctx context.Context, operation *Operation, ttl time.Duration, next RoundTripFunc) (*core.DetailedResponse, error) {
+	// The result type is part of the key because some helpers decode an operation's response differently, for
+	// example as raw JSON, and their results must not be returned to the service method.
+	key := fmt.Sprintf("%s %T %s", operation.ID, operation.result, operation.Request.URL.String())
+
+	cache.mutex.Lock()
+	if entry, ok := cache.entries[key]; ok {
+		if time.Now().Before(entry.expires) {
+			cache.mutex.Unlock()
+			cache.hits.Add(1)
+			return copyResponse(entry.response), nil
+		}
+		delete(cache.entries, key)
+	}
+	if call, ok := cache.calls[key]; ok {
+		cache.mutex.Unlock()
+		cache.coalesced.Add(1)
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, core.SDKErrorf(ctx.Err(), "", "cache-wait-error", common.GetComponentInfo())
+		}
+		// An operation abandoned by its own caller says nothing about this one, so send it again.
+		if call.err != nil && call.ctx.Err() != nil {
+			return next(ctx, operation)
+		}
+		return copyResponse(call.response), call.err
+	}
+	call := &cacheCall{
+		done: make(chan struct{}),
+		ctx:  ctx,
+	}
+	cache.calls[key] = call
+	generation := cache.generation
+	cache.mutex.Unlock()
+	cache.misses.Add(1)
+
+	response, err := next(ctx, operation)
+	call.response, call.err = copyResponse(response), err
+
+	cache.mutex.Lock()
+	delete(cache.calls, key)
+	if call.err == nil && call.response != nil && generation == cache.generation {
+		cache.store(key, &cacheEntry{
+			path:     operation.Request.URL.Path,
+			response: call.response,
+			expires:  time.Now().Add(ttl),
+		})
+	}
+	cache.mutex.Unlock()
+	close(call.done)
+
+	return response, err
+}
+
+// store adds an entry, evicting the entry closest to expiry if the cache is full.
+// The caller must hold the cache mutex.
+func (cache *ResponseCache) store(key string, entry *cacheEntry) {
+	if cache.options.MaxEntries > 0 && len(cache.entries) >= cache.options.MaxEntries {
+		var evictKey string
+		var evictExpires time.Time
+		for k, e := range cache.entries {
+			if evictKey == "" || e.expires.Before(evictExpires) {
+				evictKey, evictExpires = k, e.expires
+			}
+		}
+		delete(cache.entries, evictKey)
+	}
+	cache.entries[key] = entry
+}
+
+// invalidate removes the cached responses of the resource collection that contains path.
+// An empty path, or the path of a job operation, removes all cached responses.
+func (cache *ResponseCache) invalidate(path string) {
+	collection := resourceCollection(path)
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.generation++
+	cache.invalidations.Add(1)
+	for key, entry := range cache.entries {
+		if collection == "" || strings.HasSuffix(collection, "/jobs") || resourceCollection(entry.path) == collection {
+			delete(cache.entries, key)
+		}
+	}
+}
+
+// resourceCollection returns the collection part of an API path, e.g. "/v1/workspaces" for
+// "/v1/workspaces/{w_id}/template_data/{t_id}/values".
+func resourceCollection(path string) string {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(segments) < 2 {
+		return ""
+	}
+	return "/" + segments[0] + "/" + segments[1]
+}
+
+// copyResponse returns a copy of a response with a deep copy of its result and headers.
+func copyResponse(response *core.DetailedResponse) *core.DetailedResponse {
+	if response == nil {
+		return nil
+	}
+	copied := *response
+	copied.Headers = response.Headers.Clone()
+	if response.Result != nil {
+		copied.Result = deepCopy(reflect.ValueOf(response.Result)).Interface()
+	}
+	return &copied
+}
+
+// deepCopy returns a copy of a value that shares no pointers, slices or maps with it. Unexported struct fields
+// are copied shallowly.
+func deepCopy(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(deepCopy(value.Elem()))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(deepCopy(value.Elem()))
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(deepCopy(value.Index(i)))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for iter := value.MapRange(); iter.Next(); {
+			copied.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return copied
+	case reflect.Array, reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		if value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				copied.Index(i).Set(deepCopy(value.Index(i)))
+			}
+			return copied
+		}
+		for i := 0; i < value.NumField(); i++ {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(deepCopy(value.Field(i)))
+			}
+		}
+		return copied
+	}
+	return value
+}
@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 response cache`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var requestCount atomic.Int64
+	var delay time.Duration
+
+	BeforeEach(func() {
+		requestCount.Store(0)
+		delay = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			count := requestCount.Add(1)
+			time.Sleep(delay)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "GET":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "name": "response-%d", "actions": [], "limit": 1, "offset": 0}`, req.URL.Path, count)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "updated"}`)
+			}
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Serve repeated reads from the cache until they expire`, func() {
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{
+			TTLs: map[string]time.Duration{"GetWorkspace": 50 * time.Millisecond},
+		})
+
+		first, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		second, response, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(second).To(Equal(first))
+		Expect(second).ToNot(BeIdenticalTo(first))
+
+		*second.Name = "changed"
+		second.Tags = append(second.Tags, "changed")
+		third, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		Expect(third).To(Equal(first))
+
+		other, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws2"))
+		Expect(err).To(BeNil())
+		Expect(*other.ID).To(Equal("/v1/workspaces/ws2"))
+		Expect(requestCount.Load()).To(Equal(int64(2)))
+
+		time.Sleep(60 * time.Millisecond)
+		fourth, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		Expect(*fourth.Name).ToNot(Equal(*first.Name))
+
+		stats := cache.Stats()
+		Expect(stats.Hits).To(Equal(int64(2)))
+		Expect(stats.Misses).To(Equal(int64(3)))
+		Expect(stats.Entries).To(Equal(2))
+	})
+	It(`Do not cache operations without a TTL`, func() {
+		schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{
+			TTLs: map[string]time.Duration{"GetAction": time.Minute},
+		})
+
+		for i := 0; i < 3; i++ {
+			_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+			Expect(err).To(BeNil())
+		}
+		Expect(requestCount.Load()).To(Equal(int64(3)))
+	})
+	It(`Coalesce concurrent identical reads`, func() {
+		delay = 50 * time.Millisecond
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{
+			DefaultTTL: time.Minute,
+		})
+
+		var wg sync.WaitGroup
+		results := make([]*schematicsv1.WorkspaceResponse, 5)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				result, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+				Expect(err).To(BeNil())
+				*result.Name += fmt.Sprintf("-%d", i)
+				results[i] = result
+			}(i)
+		}
+		wg.Wait()
+
+		for i, result := range results {
+			Expect(*result.Name).To(Equal(fmt.Sprintf("response-1-%d", i)))
+		}
+
+		Expect(requestCount.Load()).To(Equal(int64(1)))
+		stats := cache.Stats()
+		Expect(stats.Misses).To(Equal(int64(1)))
+		Expect(stats.Coalesced + stats.Hits).To(Equal(int64(4)))
+	})
+	It(`Invalidate the collection changed by a mutating operation`, func() {
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{
+			DefaultTTL: time.Minute,
+		})
+
+		_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		_, _, err = schematicsService.GetAction(schematicsService.NewGetActionOptions("act1"))
+		Expect(err).To(BeNil())
+		Expect(cache.Stats().Entries).To(Equal(2))
+
+		_, _, err = schematicsService.UpdateWorkspace(schematicsService.NewUpdateWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		stats := cache.Stats()
+		Expect(stats.Entries).To(Equal(1))
+		Expect(stats.Invalidations).To(Equal(int64(1)))
+
+		_, err = schematicsService.DeleteJob(schematicsService.NewDeleteJobOptions("job1", "token"))
+		Expect(err).To(BeNil())
+		Expect(cache.Stats().Entries).To(Equal(0))
+
+		_, _, err = schematicsService.GetAction(schematicsService.NewGetActionOptions("act1"))
+		Expect(err).To(BeNil())
+		cache.Purge()
+		Expect(cache.Stats().Entries).To(Equal(0))
+	})
+	It(`Evict entries when the cache is full`, func() {
+		cache := schematicsService.EnableResponseCache(&schematicsv1.ResponseCacheOptions{
+			DefaultTTL: time.Minute,
+			MaxEntries: 2,
+		})
+
+		for _, id := range []string{"ws1", "ws2", "ws3"} {
+			_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions(id))
+			Expect(err).To(BeNil())
+		}
+		Expect(cache.Stats().Entries).To(Equal(2))
+	})
+})
@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+// The converters in this file start a read-modify-write workflow from the result of a Get operation. Each
+// converter copies every property that the request model shares with the response model; properties that only
+// the service sets (IDs, CRNs, timestamps, states) are left out. Slices are copied, but the values they point to
+// are shared with the response.
+
+// NewTemplateSourceDataRequestFromResponse : Instantiate TemplateSourceDataRequest from a TemplateSourceDataResponse
+//
+// Environment values are converted to the request's name-to-value maps, with their secure and hidden flags moved to
+// EnvValuesMetadata. Environment values without a value, such as secure values that the service does not return,
+// are left out.
+func (schematics *SchematicsV1) NewTemplateSourceDataRequestFromResponse(response *TemplateSourceDataResponse) *TemplateSourceDataRequest {
+	if response == nil {
+		return nil
+	}
+	request := &TemplateSourceDataRequest{
+		Folder:              response.Folder,
+		Compact:             response.Compact,
+		Type:                response.Type,
+		UninstallScriptName: response.UninstallScriptName,
+		Values:              response.Values,
+		ValuesMetadata:      append([]map[string]interface{}(nil), response.ValuesMetadata...),
+	}
+	for _, env := range response.EnvValues {
+		if env.Name == nil || env.Value == nil {
+			continue
+		}
+		request.EnvValues = append(request.EnvValues, map[string]interface{}{*env.Name: *env.Value})
+		if env.Secure != nil || env.Hidden != nil {
+			request.EnvValuesMetadata = append(request.EnvValuesMetadata, EnvironmentValuesMetadata{
+				Name:   env.Name,
+				Secure: env.Secure,
+				Hidden: env.Hidden,
+			})
+		}
+	}
+	for i := range response.Variablestore {
+		request.Variablestore = append(request.Variablestore, *schematics.NewWorkspaceVariableRequestFromResponse(&response.Variablestore[i]))
+	}
+	return request
+}
+
+// NewWorkspaceVariableRequestFromResponse : Instantiate WorkspaceVariableRequest from a WorkspaceVariableResponse
+func (*SchematicsV1) NewWorkspaceVariableRequestFromResponse(response *WorkspaceVariableResponse) *WorkspaceVariableRequest {
+	if response == nil {
+		return nil
+	}
+	return &WorkspaceVariableRequest{
+		Description: response.Description,
+		Name:        response.Name,
+		Secure:      response.Secure,
+		Type:        response.Type,
+		Value:       response.Value,
+	}
+}
+
+// NewTemplateRepoRequestFromResponse : Instantiate TemplateRepoRequest from a TemplateRepoResponse
+func (*SchematicsV1) NewTemplateRepoRequestFromResponse(response *TemplateRepoResponse) *TemplateRepoRequest {
+	if response == nil {
+		return nil
+	}
+	return &TemplateRepoRequest{
+		Branch:                 response.Branch,
+		Release:                response.Release,
+		RepoShaValue:           response.RepoShaValue,
+		RepoURL:                response.RepoURL,
+		URL:                    response.URL,
+		SkipSubmodulesCheckout: response.SkipSubmodulesCheckout,
+	}
+}
+
+// NewSharedTargetDataFromResponse : Instantiate SharedTargetData from a SharedTargetDataResponse
+func (*SchematicsV1) NewSharedTargetDataFromResponse(response *SharedTargetDataResponse) *SharedTargetData {
+	if response == nil {
+		return nil
+	}
+	return &SharedTargetData{
+		ClusterID:       response.ClusterID,
+		ClusterName:     response.ClusterName,
+		EntitlementKeys: append([]map[string]interface{}(nil), response.EntitlementKeys...),
+		Namespace:       response.Namespace,
+		Region:          response.Region,
+		ResourceGroupID: response.ResourceGroupID,
+	}
+}
+
+// NewCreateActionOptionsFromAction : Instantiate CreateActionOptions from an Action
+func (*SchematicsV1) NewCreateActionOptionsFromAction(action *Action) *CreateActionOptions {
+	if action == nil {
+		return nil
+	}
+	return &CreateActionOptions{
+		Name:                    action.Name,
+		Description:             action.Description,
+		Location:                action.Location,
+		ResourceGroup:           action.ResourceGroup,
+		BastionConnectionType:   action.BastionConnectionType,
+		InventoryConnectionType: action.InventoryConnectionType,
+		Tags:                    append([]string(nil), action.Tags...),
+		UserState:               action.UserState,
+		SourceReadmeURL:         action.SourceReadmeURL,
+		Source:                  action.Source,
+		SourceType:              action.SourceType,
+		CommandParameter:        action.CommandParameter,
+		Inventory:               action.Inventory,
+		Credentials:             append([]CredentialVariableData(nil), action.Credentials...),
+		Bastion:                 action.Bastion,
+		BastionCredential:       action.BastionCredential,
+		TargetsIni:              action.TargetsIni,
+		Inputs:                  append([]VariableData(nil), action.Inputs...),
+		Outputs:                 append([]VariableData(nil), action.Outputs...),
+		Settings:                append([]VariableData(nil), action.Settings...),
+	}
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fillModel sets every exported field of the struct that value points to, recursively, to a non-zero value.
+func fillModel(value reflect.Value, name string, depth int) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		value.Set(reflect.New(value.Type().Elem()))
+		fillModel(value.Elem(), name, depth)
+	case reflect.Struct:
+		if depth > 3 {
+			return
+		}
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Type().Field(i); field.IsExported() {
+				fillModel(value.Field(i), field.Name, depth+1)
+			}
+		}
+	case reflect.Slice:
+		value.Set(reflect.MakeSlice(value.Type(), 1, 1))
+		fillModel(value.Index(0), name, depth)
+	case reflect.Map:
+		value.Set(reflect.MakeMap(value.Type()))
+		value.SetMapIndex(reflect.ValueOf(name), reflect.ValueOf(name).Convert(value.Type().Elem()))
+	case reflect.Interface:
+		value.Set(reflect.ValueOf(name))
+	case reflect.String:
+		value.SetString(name)
+	case reflect.Bool:
+		value.SetBool(true)
+	case reflect.Int, reflect.Int64:
+		value.SetInt(1)
+	case reflect.Float64:
+		value.SetFloat(1)
+	}
+}
+
+// expectNoDroppedFields checks that every field of the response is copied to the request field of the same name,
+// unless it is listed as response-only, and that every request field is set, unless it is listed as request-only.
+func expectNoDroppedFields(response interface{}, request interface{}, responseOnly []string, requestOnly []string) {
+	responseValue := reflect.ValueOf(response).Elem()
+	requestValue := reflect.ValueOf(request).Elem()
+	for i := 0; i < responseValue.NumField(); i++ {
+		name := responseValue.Type().Field(i).Name
+		requestField := requestValue.FieldByName(name)
+		if contains(responseOnly, name) {
+			Expect(requestField.IsValid()).To(BeFalse(), fmt.Sprintf("%s is listed as response-only", name))
+			continue
+		}
+		Expect(requestField.IsValid()).To(BeTrue(), fmt.Sprintf("%s is not converted", name))
+		if requestField.Type() == responseValue.Field(i).Type() {
+			Expect(requestField.Interface()).To(Equal(responseValue.Field(i).Interface()), fmt.Sprintf("%s is not copied", name))
+		}
+	}
+	for i := 0; i < requestValue.NumField(); i++ {
+		name := requestValue.Type().Field(i).Name
+		if !contains(requestOnly, name) {
+			Expect(requestValue.Field(i).IsZero()).To(BeFalse(), fmt.Sprintf("%s is not set", name))
+		}
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+var _ = Describe(`SchematicsV1 model converters`, func() {
+	schematicsService := &schematicsv1.SchematicsV1{}
+
+	newModel := func(model interface{}) interface{} {
+		fillModel(reflect.ValueOf(model).Elem(), "", 0)
+		return model
+	}
+
+	It(`Convert TemplateSourceDataResponse without dropping fields`, func() {
+		response := newModel(&schematicsv1.TemplateSourceDataResponse{}).(*schematicsv1.TemplateSourceDataResponse)
+		request := schematicsService.NewTemplateSourceDataRequestFromResponse(response)
+		expectNoDroppedFields(response, request,
+			[]string{"HasGithubtoken", "ID", "ValuesURL"},
+			[]string{"InitStateFile", "Injectors"})
+		Expect(request.EnvValues).To(Equal([]map[string]interface{}{{"Name": "Value"}}))
+		Expect(request.EnvValuesMetadata).To(Equal([]schematicsv1.EnvironmentValuesMetadata{{
+			Name: response.EnvValues[0].Name, Secure: response.EnvValues[0].Secure, Hidden: response.EnvValues[0].Hidden,
+		}}))
+		Expect(request.Variablestore).To(Equal([]schematicsv1.WorkspaceVariableRequest{
+			*schematicsService.NewWorkspaceVariableRequestFromResponse(&response.Variablestore[0]),
+		}))
+	})
+	It(`Convert WorkspaceVariableResponse without dropping fields`, func() {
+		response := newModel(&schematicsv1.WorkspaceVariableResponse{}).(*schematicsv1.WorkspaceVariableResponse)
+		expectNoDroppedFields(response, schematicsService.NewWorkspaceVariableRequestFromResponse(response),
+			nil,
+			[]string{"UseDefault"})
+	})
+	It(`Convert TemplateRepoResponse without dropping fields`, func() {
+		response := newModel(&schematicsv1.TemplateRepoResponse{}).(*schematicsv1.TemplateRepoResponse)
+		expectNoDroppedFields(response, schematicsService.NewTemplateRepoRequestFromResponse(response),
+			[]string{"FullURL", "HasUploadedgitrepotar"},
+			nil)
+	})
+	It(`Convert SharedTargetDataResponse without dropping fields`, func() {
+		response := newModel(&schematicsv1.SharedTargetDataResponse{}).(*schematicsv1.SharedTargetDataResponse)
+		expectNoDroppedFields(response, schematicsService.NewSharedTargetDataFromResponse(response),
+			nil,
+			[]string{"ClusterCreatedOn", "ClusterType", "WorkerCount", "WorkerMachineType"})
+	})
+	It(`Convert Action without dropping fields`, func() {
+		response := newModel(&schematicsv1.Action{}).(*schematicsv1.Action)
+		expectNoDroppedFields(response, schematicsService.NewCreateActionOptionsFromAction(response),
+			[]string{"ID", "Crn", "Account", "SourceCreatedAt", "SourceCreatedBy", "SourceUpdatedAt", "SourceUpdatedBy",
+				"CreatedAt", "CreatedBy", "UpdatedAt", "UpdatedBy", "State", "PlaybookNames", "SysLock", "GitTokenRef", "Encryption"},
+			[]string{"XGithubToken", "Headers"})
+	})
+	It(`Convert nil responses to nil requests`, func() {
+		Expect(schematicsService.NewTemplateSourceDataRequestFromResponse(nil)).To(BeNil())
+		Expect(schematicsService.NewWorkspaceVariableRequestFromResponse(nil)).To(BeNil())
+		Expect(schematicsService.NewTemplateRepoRequestFromResponse(nil)).To(BeNil())
+		Expect(schematicsService.NewSharedTargetDataFromResponse(nil)).To(BeNil())
+		Expect(schematicsService.NewCreateActionOptionsFromAction(nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// RedactedValue replaces secret values in the requests recorded by a DryRun.
+const RedactedValue = "[REDACTED]"
+
+// sensitiveNamePattern matches the names of headers and body properties that hold secrets.
+var sensitiveNamePattern = regexp.MustCompile(`(?i)(token|password|passwd|secret|apikey|api_key|private_key|ssh_key|authorization|credential)`)
+
+// DryRunRequest : a mutating request that was recorded by a DryRun instead of being sent.
+type DryRunRequest struct {
+	// The operationId of the service method (e.g. "CreateWorkspace").
+	OperationID string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The full request URL, including query parameters.
+	URL string
+
+	// The path of the request URL (e.g. "/v1/workspaces/{w_id}").
+	Path string
+
+	// The request headers, with secret values redacted.
+	Headers http.Header
+
+	// The request body, with secret values redacted. JSON bodies are re-encoded with secret properties,
+	// the values of secure variables and secure or hidden environment values replaced by RedactedValue;
+	// other bodies (e.g. file uploads) are summarized by their content type and size.
+	Body string
+}
+
+// DryRun : a middleware that records mutating operations instead of sending them.
+//
+// Service methods validate their options and build their requests as usual. Operations that change
+// resources are then recorded and answered with a synthetic result: the zero value of the method's
+// result type, with a generated ID for Create operations. Read-only operations (see Operation.IsReadOnly)
+// are sent to the service normally. A DryRun is safe for concurrent use.
+type DryRun struct {
+	mutex    sync.Mutex
+	requests []DryRunRequest
+}
+
+// NewDryRun : constructs a DryRun with no recorded requests.
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// EnableDryRun adds a DryRun to the middleware chain of this service instance and returns it.
+// Middleware added after the DryRun does not see the recorded operations.
+func (schematics *SchematicsV1) EnableDryRun() *DryRun {
+	dryRun := NewDryRun()
+	schematics.Use(dryRun.Middleware())
+	return dryRun
+}
+
+// Middleware returns a Middleware that records mutating operations and passes read-only operations on.
+func (dryRun *DryRun) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, operation *Operation) (*core.DetailedResponse, error) {
+			if operation.IsReadOnly() {
+				return next(ctx, operation)
+			}
+
+			recorded, err := newDryRunRequest(operation)
+			if err != nil {
+				return nil, core.SDKErrorf(err, "", "dry-run-record-error", common.GetComponentInfo())
+			}
+
+			dryRun.mutex.Lock()
+			dryRun.requests = append(dryRun.requests, recorded)
+			sequence := len(dryRun.requests)
+			dryRun.mutex.Unlock()
+
+			return syntheticResponse(operation, sequence), nil
+		}
+	}
+}
+
+// Requests returns the requests recorded so far, in the order they were made.
+func (dryRun *DryRun) Requests() []DryRunRequest {
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	return append([]DryRunRequest(nil), dryRun.requests...)
+}
+
+// Reset discards the recorded requests.
+func (dryRun *DryRun) Reset() {
+	dryRun.mutex.Lock()
+	defer dryRun.mutex.Unlock()
+	dryRun.requests = nil
+}
+
+// newDryRunRequest records an operation's request, consuming its body.
+func newDryRunRequest(operation *Operation) (recorded DryRunRequest, err error) {
+	request := operation.Request
+	recorded = DryRunRequest{
+		OperationID: operation.ID,
+		Method:      request.Method,
+		URL:         request.URL.String(),
+		Path:        request.URL.Path,
+		Headers:     redactHeaders(request.Header),
+	}
+
+	if request.Body == nil {
+		return
+	}
+	defer request.Body.Close()
+	body, err := io.ReadAll(request.Body)
+	if err != nil || len(body) == 0 {
+		return
+	}
+
+	contentType := request.Header.Get(core.CONTENT_TYPE)
+	if !core.IsJSONMimeType(contentType) {
+		recorded.Body = fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+		return
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(body, &decoded); err != nil {
+		return
+	}
+	redacted, err := json.Marshal(redactBody(decoded))
+	recorded.Body = string(redacted)
+	return
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for name := range redacted {
+		if sensitiveNamePattern.MatchString(name) {
+			redacted[name] = []string{RedactedValue}
+		}
+	}
+	return redacted
+}
+
+// redactBody replaces secrets in a decoded JSON body: the values of properties with sensitive names,
+// the values of variables marked secure (directly or in their metadata), the environment values marked
+// secure or hidden by their env_values_metadata and all credential values.
+func redactBody(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redactEnvValues(v)
+		secure := isSecureVariable(v)
+		for name, property := range v {
+			switch {
+			case property == nil:
+			case sensitiveNamePattern.MatchString(name) || (secure && name == "value"):
+				v[name] = redactAll(property)
+			default:
+				v[name] = redactBody(property)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactBody(v[i])
+		}
+	}
+	return value
+}
+
+// redactAll replaces every scalar within value, keeping the structure, names and metadata
+// (e.g. of a credential list).
+func redactAll(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, property := range v {
+			if name != "name" && name != "metadata" {
+				v[name] = redactAll(property)
+			}
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactAll(v[i])
+		}
+		return v
+	default:
+		return RedactedValue
+	}
+}
+
+// redactEnvValues replaces the environment values of a template data object that its env_values_metadata marks
+// secure or hidden.
+func redactEnvValues(templateData map[string]interface{}) {
+	forEachProtectedEnvValue(templateData, func(values map[string]interface{}, name string) {
+		if values[name] != nil {
+			values[name] = RedactedValue
+		}
+	})
+}
+
+// forEachProtectedEnvValue calls visit for each environment value of a template data object that its
+// env_values_metadata marks secure or hidden. Request env_values are lists of name-to-value maps.
+func forEachProtectedEnvValue(templateData map[string]interface{}, visit func(values map[string]interface{}, name string)) {
+	envValues, _ := templateData["env_values"].([]interface{})
+	metadata, _ := templateData["env_values_metadata"].([]interface{})
+	protected := map[string]bool{}
+	for _, item := range metadata {
+		if itemMetadata, ok := item.(map[string]interface{}); ok && isSecureVariable(itemMetadata) {
+			if name, ok := itemMetadata["name"].(string); ok {
+				protected[name] = true
+			}
+		}
+	}
+	for _, item := range envValues {
+		if values, ok := item.(map[string]interface{}); ok {
+			for name := range values {
+				if protected[name] {
+					visit(values, name)
+				}
+			}
+		}
+	}
+}
+
+// isSecureVariable reports whether a variable, or the metadata of an environment value, is marked secure or
+// hidden, directly or in its metadata.
+func isSecureVariable(variable map[string]interface{}) bool {
+	secure, _ := variable["secure"].(bool)
+	hidden, _ := variable["hidden"].(bool)
+	if secure || hidden {
+		return true
+	}
+	if metadata, ok := variable["metadata"].(map[string]interface{}); ok {
+		secure, _ := metadata["secure"].(bool)
+		return secure
+	}
+	return false
+}
+
+// syntheticResponse returns the response of an operation that was recorded instead of sent.
+func syntheticResponse(operation *Operation, sequence int) *core.DetailedResponse {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusOK,
+		Headers:    http.Header{},
+	}
+	if strings.HasPrefix(operation.ID, "Create") {
+		response.StatusCode = http.StatusCreated
+	}
+
+	if operation.result == nil {
+		return response
+	}
+	resultType := reflect.TypeOf(operation.result).Elem()
+	if resultType.Kind() != reflect.Ptr {
+		return response
+	}
+	result := reflect.New(resultType.Elem())
+	if response.StatusCode == http.StatusCreated {
+		if value := result.Elem(); value.Kind() == reflect.Struct {
+			if field := value.FieldByName("ID"); field.IsValid() && field.Type() == reflect.TypeOf((*string)(nil)) {
+				field.Set(reflect.ValueOf(core.StringPtr(fmt.Sprintf("dry-run.%s.%d", strings.ToLower(strings.TrimPrefix(operation.ID, "Create")), sequence))))
+			}
+		}
+	}
+	response.Result = result.Interface()
+	return response
+}
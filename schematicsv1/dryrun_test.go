@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 dry run`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var dryRun *schematicsv1.DryRun
+	var requestMethods []string
+
+	BeforeEach(func() {
+		requestMethods = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requestMethods = append(requestMethods, req.Method)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "%s", "name": "existing"}`, req.URL.Path)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		dryRun = schematicsService.EnableDryRun()
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Send read operations to the service`, func() {
+		result, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("ws1"))
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("existing"))
+
+		_, _, err = schematicsService.ExecuteResourceQuery(schematicsService.NewExecuteResourceQueryOptions("query1"))
+		Expect(err).To(BeNil())
+		Expect(requestMethods).To(Equal([]string{"GET", "POST"}))
+		Expect(dryRun.Requests()).To(BeEmpty())
+	})
+	It(`Record mutating operations and return synthetic results`, func() {
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName("prod")
+		createWorkspaceOptions.SetXGithubToken("ghp_secret")
+		createWorkspaceOptions.SetTemplateData([]schematicsv1.TemplateSourceDataRequest{{
+			Variablestore: []schematicsv1.WorkspaceVariableRequest{
+				{Name: core.StringPtr("region"), Value: core.StringPtr("us-south")},
+				{Name: core.StringPtr("db_password"), Value: core.StringPtr("hunter2"), Secure: core.BoolPtr(true)},
+			},
+		}})
+		workspace, response, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*workspace.ID).To(Equal("dry-run.workspace.1"))
+
+		applyOptions := schematicsService.NewApplyWorkspaceCommandOptions("ws1", "refresh-secret")
+		activity, _, err := schematicsService.ApplyWorkspaceCommand(applyOptions)
+		Expect(err).To(BeNil())
+		Expect(activity).ToNot(BeNil())
+
+		_, err = schematicsService.DeleteAction(schematicsService.NewDeleteActionOptions("act1"))
+		Expect(err).To(BeNil())
+		Expect(requestMethods).To(BeEmpty())
+
+		requests := dryRun.Requests()
+		Expect(requests).To(HaveLen(3))
+
+		Expect(requests[0].OperationID).To(Equal("CreateWorkspace"))
+		Expect(requests[0].Method).To(Equal("POST"))
+		Expect(requests[0].Path).To(Equal("/v1/workspaces"))
+		Expect(requests[0].Headers["X-Github-token"]).To(Equal([]string{schematicsv1.RedactedValue}))
+		Expect(requests[0].Body).To(ContainSubstring(`"name":"prod"`))
+		Expect(requests[0].Body).To(ContainSubstring(`"value":"us-south"`))
+		Expect(requests[0].Body).ToNot(ContainSubstring("hunter2"))
+		Expect(requests[0].Body).ToNot(ContainSubstring("ghp_secret"))
+
+		Expect(requests[1].OperationID).To(Equal("ApplyWorkspaceCommand"))
+		Expect(requests[1].Path).To(Equal("/v1/workspaces/ws1/apply"))
+		Expect(requests[1].Headers["refresh_token"]).To(Equal([]string{schematicsv1.RedactedValue}))
+
+		Expect(requests[2].OperationID).To(Equal("DeleteAction"))
+		Expect(requests[2].Method).To(Equal("DELETE"))
+
+		dryRun.Reset()
+		Expect(dryRun.Requests()).To(BeEmpty())
+	})
+	It(`Redact secure and hidden environment values`, func() {
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetTemplateData([]schematicsv1.TemplateSourceDataRequest{{
+			EnvValues: []map[string]interface{}{
+				{"TF_LOG": "DEBUG"},
+				{"IC_API_KEY_VALUE": "s3cr3t-key"},
+				{"DB_CONNECTION": "postgres://admin:pa55@db"},
+			},
+			EnvValuesMetadata: []schematicsv1.EnvironmentValuesMetadata{
+				{Name: core.StringPtr("TF_LOG"), Secure: core.BoolPtr(false)},
+				{Name: core.StringPtr("IC_API_KEY_VALUE"), Secure: core.BoolPtr(true)},
+				{Name: core.StringPtr("DB_CONNECTION"), Hidden: core.BoolPtr(true)},
+			},
+		}})
+		_, _, err := schematicsService.CreateWorkspace(createWorkspaceOptions)
+		Expect(err).To(BeNil())
+
+		requests := dryRun.Requests()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Body).To(ContainSubstring(`{"TF_LOG":"DEBUG"}`))
+		Expect(requests[0].Body).To(ContainSubstring(`{"IC_API_KEY_VALUE":"[REDACTED]"}`))
+		Expect(requests[0].Body).To(ContainSubstring(`{"DB_CONNECTION":"[REDACTED]"}`))
+		Expect(requests[0].Body).ToNot(ContainSubstring("s3cr3t-key"))
+		Expect(requests[0].Body).ToNot(ContainSubstring("pa55"))
+	})
+	It(`Validate options before recording`, func() {
+		_, _, err := schematicsService.UpdateWorkspace(&schematicsv1.UpdateWorkspaceOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(dryRun.Requests()).To(BeEmpty())
+	})
+	It(`Summarize file uploads`, func() {
+		uploadOptions := schematicsService.NewTemplateRepoUploadOptions("ws1", "tid1")
+		uploadOptions.SetFile(io.NopCloser(bytes.NewReader([]byte("archive"))))
+		_, _, err := schematicsService.TemplateRepoUpload(uploadOptions)
+		Expect(err).To(BeNil())
+
+		requests := dryRun.Requests()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Body).To(HavePrefix("<"))
+		Expect(requests[0].Body).To(ContainSubstring("multipart/form-data"))
+	})
+})
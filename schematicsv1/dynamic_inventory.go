@@ -0,0 +1,385 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// The implicit groups of an Ansible inventory.
+const (
+	DynamicInventoryGroupAll       = "all"
+	DynamicInventoryGroupUngrouped = "ungrouped"
+)
+
+// MaxInventoryRangeHosts is the maximum number of hosts that a host pattern with ranges can expand to.
+const MaxInventoryRangeHosts = 10000
+
+// inventoryRangePattern matches a range of a host pattern, such as "[01:20]", "[a:f]" or "[0:30:10]".
+var inventoryRangePattern = regexp.MustCompile(`\[([A-Za-z0-9]+):([A-Za-z0-9]+)(?::(\d+))?\]`)
+
+// resourceQueryAddressNames are the names of resource query outputs that hold the address of a host, in order
+// of preference.
+var resourceQueryAddressNames = []string{"floating_ip", "public_ip", "primary_ipv4_address", "ipv4_address", "private_ip", "ip_address", "ip"}
+
+// inventoryNameInvalidCharacters matches the characters that are not valid in Ansible variable and group names.
+var inventoryNameInvalidCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// ResourceQueryErrors : errors of resource queries, keyed by query ID.
+type ResourceQueryErrors map[string]error
+
+// Error returns a summary of the resource query errors.
+func (errs ResourceQueryErrors) Error() string {
+	return summarizeErrors("resource query", errs)
+}
+
+// DynamicInventory : an inventory in the JSON format of Ansible inventory scripts. Its JSON encoding is the output
+// of "--list"; the variables of a host are the output of "--host".
+type DynamicInventory struct {
+	// The groups, keyed by name.
+	Groups map[string]*DynamicInventoryGroup
+
+	// The variables of the hosts, keyed by host name.
+	HostVars map[string]map[string]interface{}
+}
+
+// DynamicInventoryGroup : a group of a DynamicInventory.
+type DynamicInventoryGroup struct {
+	Hosts    []string               `json:"hosts,omitempty"`
+	Vars     map[string]interface{} `json:"vars,omitempty"`
+	Children []string               `json:"children,omitempty"`
+}
+
+// NewDynamicInventory : returns an empty DynamicInventory.
+func NewDynamicInventory() *DynamicInventory {
+	return &DynamicInventory{
+		Groups:   map[string]*DynamicInventoryGroup{},
+		HostVars: map[string]map[string]interface{}{},
+	}
+}
+
+// MarshalJSON encodes the inventory with its host variables in the "_meta" entry, so that Ansible does not call
+// the inventory script for each host.
+func (inventory *DynamicInventory) MarshalJSON() ([]byte, error) {
+	entries := make(map[string]interface{}, len(inventory.Groups)+1)
+	for name, group := range inventory.Groups {
+		entries[name] = group
+	}
+	hostVars := inventory.HostVars
+	if hostVars == nil {
+		hostVars = map[string]map[string]interface{}{}
+	}
+	entries["_meta"] = map[string]interface{}{"hostvars": hostVars}
+	return json.Marshal(entries)
+}
+
+// Host returns the variables of a host, which are empty for an unknown host.
+func (inventory *DynamicInventory) Host(name string) map[string]interface{} {
+	if vars := inventory.HostVars[name]; vars != nil {
+		return vars
+	}
+	return map[string]interface{}{}
+}
+
+// group returns the group with the specified name, adding it if there is none.
+func (inventory *DynamicInventory) group(name string) *DynamicInventoryGroup {
+	group := inventory.Groups[name]
+	if group == nil {
+		group = &DynamicInventoryGroup{}
+		inventory.Groups[name] = group
+	}
+	return group
+}
+
+// addHost adds a host to a group and merges vars into the variables of the host.
+func (inventory *DynamicInventory) addHost(groupName string, host string, vars map[string]interface{}) {
+	group := inventory.group(groupName)
+	if !slices.Contains(group.Hosts, host) {
+		group.Hosts = append(group.Hosts, host)
+	}
+	hostVars := inventory.HostVars[host]
+	if hostVars == nil {
+		hostVars = map[string]interface{}{}
+		inventory.HostVars[host] = hostVars
+	}
+	for name, value := range vars {
+		hostVars[name] = value
+	}
+}
+
+// addChild adds a child group to a group.
+func (inventory *DynamicInventory) addChild(groupName string, child string) {
+	group := inventory.group(groupName)
+	if !slices.Contains(group.Children, child) {
+		group.Children = append(group.Children, child)
+	}
+	inventory.group(child)
+}
+
+// finish makes the groups that are no other group's children the children of the "all" group, as Ansible does.
+func (inventory *DynamicInventory) finish() {
+	isChild := map[string]bool{DynamicInventoryGroupAll: true}
+	for name, group := range inventory.Groups {
+		if name != DynamicInventoryGroupAll {
+			for _, child := range group.Children {
+				isChild[child] = true
+			}
+		}
+	}
+	all := inventory.group(DynamicInventoryGroupAll)
+	all.Children = nil
+	for _, name := range sortedKeys(inventory.Groups) {
+		if !isChild[name] {
+			all.Children = append(all.Children, name)
+		}
+	}
+}
+
+// AddInventory adds the groups and hosts of an INI inventory. Host patterns with ranges are expanded, ports of
+// host names become their ansible_port variable, and host variables that are Python literals (numbers, True and
+// False) become numbers and booleans, as Ansible interprets them. Variables of [group:vars] sections remain
+// strings. Hosts outside of groups are added to the "ungrouped" group.
+func (inventory *DynamicInventory) AddInventory(ini *Inventory) error {
+	addHosts := func(groupName string, hosts []*InventoryHost) error {
+		for _, host := range hosts {
+			names, port, err := expandInventoryHost(host.Name)
+			if err != nil {
+				return err
+			}
+			vars := map[string]interface{}{}
+			if port != 0 {
+				vars["ansible_port"] = port
+			}
+			for _, variable := range host.Vars {
+				vars[variable.Name] = inventoryLiteral(variable.Value)
+			}
+			for _, name := range names {
+				inventory.addHost(groupName, name, vars)
+			}
+		}
+		return nil
+	}
+
+	if err := addHosts(DynamicInventoryGroupUngrouped, ini.Hosts); err != nil {
+		return err
+	}
+	for _, iniGroup := range ini.Groups {
+		group := inventory.group(iniGroup.Name)
+		if err := addHosts(iniGroup.Name, iniGroup.Hosts); err != nil {
+			return err
+		}
+		for _, variable := range iniGroup.Vars {
+			if group.Vars == nil {
+				group.Vars = map[string]interface{}{}
+			}
+			group.Vars[variable.Name] = variable.Value
+		}
+		for _, child := range iniGroup.Children {
+			inventory.addChild(iniGroup.Name, child)
+		}
+	}
+	inventory.finish()
+	return nil
+}
+
+// AddResourceQuery adds the hosts of the result of a resource query to a group. See AddResourceQueryHosts.
+func (inventory *DynamicInventory) AddResourceQuery(groupName string, result *ResourceQueryResponseRecord) {
+	inventory.AddResourceQueryHosts(groupName, NewResourceQueryHosts(groupName, result))
+}
+
+// AddResourceQueryHosts adds the resources of a resource query to a group. A host is added for each resource,
+// named by its address (the first of its floating_ip, public_ip, primary_ipv4_address, ipv4_address, private_ip,
+// ip_address or ip outputs); its other outputs become its variables. Resources without an address are skipped.
+func (inventory *DynamicInventory) AddResourceQueryHosts(groupName string, hosts ResourceQueryHosts) {
+	groupName = inventoryGroupName(groupName)
+	inventory.group(groupName)
+	for _, host := range hosts {
+		addressOutput := host.addressOutput()
+		if addressOutput == "" {
+			continue
+		}
+		vars := map[string]interface{}{}
+		for name, value := range host.Outputs {
+			if name != addressOutput {
+				vars[name] = value
+			}
+		}
+		inventory.addHost(groupName, host.Outputs[addressOutput], vars)
+	}
+	inventory.finish()
+}
+
+// ResolveInventory returns the dynamic inventory of an inventory. See ResolveInventoryRecord.
+func (schematics *SchematicsV1) ResolveInventory(ctx context.Context, inventoryID string) (*DynamicInventory, error) {
+	record, _, err := schematics.GetInventoryWithContext(ctx, schematics.NewGetInventoryOptions(inventoryID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-inventory-error")
+	}
+	return schematics.ResolveInventoryRecord(ctx, record)
+}
+
+// ResolveInventoryRecord returns the dynamic inventory of an inventory record: the hosts of its InventoriesIni
+// (see AddInventory) and the hosts of each of its ResourceQueries, which are run concurrently with
+// ExecuteResourceQuery. The hosts of a resource query are added to a group named after the query (see
+// AddResourceQueryHosts). If resource queries fail, the inventory of the other sources is returned along with
+// ResourceQueryErrors.
+func (schematics *SchematicsV1) ResolveInventoryRecord(ctx context.Context, record *InventoryResourceRecord) (*DynamicInventory, error) {
+	inventory := NewDynamicInventory()
+	if ini := core.StringNilMapper(record.InventoriesIni); strings.TrimSpace(ini) != "" {
+		parsed, err := ParseInventory(ini)
+		if err != nil {
+			return nil, err
+		}
+		if err := inventory.AddInventory(parsed); err != nil {
+			return nil, err
+		}
+	}
+
+	results, errs := concurrently(ctx, record.ResourceQueries, schematics.executeResourceQuery)
+	for _, queryID := range record.ResourceQueries {
+		if result, ok := results[queryID]; ok {
+			inventory.AddResourceQueryHosts(result.name, result.hosts)
+		}
+	}
+	inventory.finish()
+	if len(errs) > 0 {
+		return inventory, ResourceQueryErrors(errs)
+	}
+	return inventory, nil
+}
+
+// inventoryGroupName returns a valid Ansible group name for a name such as a resource query name.
+func inventoryGroupName(name string) string {
+	name = inventoryNameInvalidCharacters.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// inventoryLiteral returns the value of an INI host variable as Ansible interprets it.
+func inventoryLiteral(value string) interface{} {
+	switch value {
+	case "True":
+		return true
+	case "False":
+		return false
+	}
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return number
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil && strings.ContainsAny(value, ".eE") {
+		return number
+	}
+	return value
+}
+
+// expandInventoryHost returns the host names of an INI host pattern, without its port, and the port.
+func expandInventoryHost(pattern string) (names []string, port int, err error) {
+	if match := inventoryBracketedIPv6Pattern.FindStringSubmatch(pattern); match != nil {
+		port, _ = strconv.Atoi(match[2])
+		return []string{match[1]}, port, nil
+	}
+	if match := inventoryHostPattern.FindStringSubmatch(pattern); match != nil {
+		pattern = match[1]
+		if match[2] != "" {
+			port, _ = strconv.Atoi(match[2])
+		}
+	}
+
+	names = []string{""}
+	for pattern != "" {
+		location := inventoryRangePattern.FindStringSubmatchIndex(pattern)
+		if location == nil {
+			for i := range names {
+				names[i] += pattern
+			}
+			break
+		}
+		values, err := expandInventoryRange(pattern[location[2]:location[3]], pattern[location[4]:location[5]], pattern, location, MaxInventoryRangeHosts/len(names))
+		if err != nil {
+			return nil, 0, err
+		}
+		prefix := pattern[:location[0]]
+		expanded := make([]string, 0, len(names)*len(values))
+		for _, name := range names {
+			for _, value := range values {
+				expanded = append(expanded, name+prefix+value)
+			}
+		}
+		names = expanded
+		pattern = pattern[location[1]:]
+	}
+	return names, port, nil
+}
+
+// expandInventoryRange returns the values of a numeric or alphabetic range of a host pattern, of which there can be
+// at most limit.
+func expandInventoryRange(start string, end string, pattern string, location []int, limit int) ([]string, error) {
+	step := 1
+	if location[6] >= 0 {
+		step, _ = strconv.Atoi(pattern[location[6]:location[7]])
+	}
+	invalid := func() ([]string, error) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid range '%s' of host pattern '%s'", pattern[location[0]:location[1]], pattern), "inventory-range-error", common.GetComponentInfo())
+	}
+	if step <= 0 {
+		return invalid()
+	}
+
+	tooLarge := func() ([]string, error) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("range '%s' of host pattern '%s' expands to more than %d hosts", pattern[location[0]:location[1]], pattern, MaxInventoryRangeHosts), "inventory-range-error", common.GetComponentInfo())
+	}
+
+	var values []string
+	first, firstErr := strconv.Atoi(start)
+	last, lastErr := strconv.Atoi(end)
+	switch {
+	case firstErr == nil && lastErr == nil:
+		if first > last {
+			return invalid()
+		}
+		if (last-first)/step >= limit {
+			return tooLarge()
+		}
+		for value := first; value <= last; value += step {
+			values = append(values, fmt.Sprintf("%0*d", len(start), value))
+		}
+	case len(start) == 1 && len(end) == 1 && firstErr != nil && lastErr != nil:
+		if start[0] > end[0] {
+			return invalid()
+		}
+		if int(end[0]-start[0])/step >= limit {
+			return tooLarge()
+		}
+		for value := int(start[0]); value <= int(end[0]); value += step {
+			values = append(values, string(rune(value)))
+		}
+	default:
+		return invalid()
+	}
+	return values, nil
+}
@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 dynamic inventories`, func() {
+	const ini = `
+bastion.example.com:2222 ansible_user=root
+[web]
+web[08:10].example.com http_port=8080 debug=True ratio=0.5 name=web
+[db]
+db-[a:b].example.com
+[2001:db8::1]:22
+[db:vars]
+pool_size=10
+[app:children]
+web
+db
+`
+	const queryResult = `{"response": [{"query_type": "workspaces", "query_output": [
+		{"name": "result.resources.instances.attributes.name", "value": "vsi-1"},
+		{"name": "result.resources.instances.attributes.ipv4_address", "value": "10.0.0.1"},
+		{"name": "result.resources.instances.attributes.floating_ip", "value": "169.0.0.1"},
+		{"name": "result.resources.instances.attributes.name", "value": "vsi-2"},
+		{"name": "result.resources.instances.attributes.ipv4_address", "value": "10.0.0.2"},
+		{"name": "result.resources.instances.attributes.name", "value": "no-address"}
+	]}]}`
+
+	It(`Convert an INI inventory`, func() {
+		parsed, err := schematicsv1.ParseInventory(ini)
+		Expect(err).To(BeNil())
+		inventory := schematicsv1.NewDynamicInventory()
+		Expect(inventory.AddInventory(parsed)).To(Succeed())
+
+		Expect(inventory.Groups["ungrouped"].Hosts).To(Equal([]string{"bastion.example.com"}))
+		Expect(inventory.Groups["web"].Hosts).To(Equal([]string{"web08.example.com", "web09.example.com", "web10.example.com"}))
+		Expect(inventory.Groups["db"].Hosts).To(Equal([]string{"db-a.example.com", "db-b.example.com", "2001:db8::1"}))
+		Expect(inventory.Groups["db"].Vars).To(Equal(map[string]interface{}{"pool_size": "10"}))
+		Expect(inventory.Groups["app"].Children).To(Equal([]string{"web", "db"}))
+		Expect(inventory.Groups["all"].Children).To(Equal([]string{"app", "ungrouped"}))
+
+		Expect(inventory.Host("bastion.example.com")).To(Equal(map[string]interface{}{"ansible_port": 2222, "ansible_user": "root"}))
+		Expect(inventory.Host("web09.example.com")).To(Equal(map[string]interface{}{"http_port": int64(8080), "debug": true, "ratio": 0.5, "name": "web"}))
+		Expect(inventory.Host("2001:db8::1")).To(Equal(map[string]interface{}{"ansible_port": 22}))
+		Expect(inventory.Host("unknown")).To(BeEmpty())
+
+		output, err := json.Marshal(inventory)
+		Expect(err).To(BeNil())
+		var decoded map[string]map[string]interface{}
+		Expect(json.Unmarshal(output, &decoded)).To(Succeed())
+		Expect(decoded).To(HaveKey("_meta"))
+		Expect(decoded["_meta"]["hostvars"]).To(HaveKey("web10.example.com"))
+		Expect(decoded["web"]["hosts"]).To(HaveLen(3))
+
+		parsed, err = schematicsv1.ParseInventory("[web]\nweb[5:1].example.com\n")
+		Expect(err).To(BeNil())
+		err = schematicsv1.NewDynamicInventory().AddInventory(parsed)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid range '[5:1]'"))
+
+		parsed, err = schematicsv1.ParseInventory("[web]\nweb[0:99].rack[0:99].example.com\n")
+		Expect(err).To(BeNil())
+		Expect(schematicsv1.NewDynamicInventory().AddInventory(parsed)).To(Succeed())
+
+		for _, pattern := range []string{"web[0:999999999999]", "web[0:99].rack[0:100]", "web[a:z].rack[0:999]"} {
+			parsed, err = schematicsv1.ParseInventory("[web]\n" + pattern + "\n")
+			Expect(err).To(BeNil())
+			err = schematicsv1.NewDynamicInventory().AddInventory(parsed)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("expands to more than 10000 hosts"))
+		}
+	})
+	It(`Convert a resource query result`, func() {
+		var result *schematicsv1.ResourceQueryResponseRecord
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(queryResult), &raw)).To(Succeed())
+		Expect(core.UnmarshalModel(raw, "", &result, schematicsv1.UnmarshalResourceQueryResponseRecord)).To(Succeed())
+
+		inventory := schematicsv1.NewDynamicInventory()
+		inventory.AddResourceQuery("prod vsis", result)
+		Expect(inventory.Groups["prod_vsis"].Hosts).To(Equal([]string{"169.0.0.1", "10.0.0.2"}))
+		Expect(inventory.Host("169.0.0.1")).To(Equal(map[string]interface{}{"name": "vsi-1", "ipv4_address": "10.0.0.1"}))
+		Expect(inventory.Groups["all"].Children).To(Equal([]string{"prod_vsis"}))
+	})
+
+	Describe(`Resolve inventories`, func() {
+		var testServer *httptest.Server
+		var schematicsService *schematicsv1.SchematicsV1
+
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.URL.Path == "/v2/inventories/inv1":
+					body, _ := json.Marshal(map[string]interface{}{
+						"id":               "inv1",
+						"inventories_ini":  ini,
+						"resource_queries": []string{"q1", "q2"},
+					})
+					res.Write(body)
+				case req.URL.Path == "/v2/resources_query/q1" && req.Method == http.MethodGet:
+					fmt.Fprint(res, `{"id": "q1", "name": "vsis"}`)
+				case req.URL.Path == "/v2/resources_query/q1" && req.Method == http.MethodPost:
+					fmt.Fprint(res, queryResult)
+				default:
+					res.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+				}
+			}))
+			var serviceErr error
+			schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Combine the INI inventory and the resource queries`, func() {
+			inventory, err := schematicsService.ResolveInventory(context.Background(), "inv1")
+			Expect(inventory).ToNot(BeNil())
+			Expect(err).ToNot(BeNil())
+			Expect(err).To(BeAssignableToTypeOf(schematicsv1.ResourceQueryErrors{}))
+			Expect(err.(schematicsv1.ResourceQueryErrors)).To(HaveKey("q2"))
+
+			Expect(inventory.Groups["web"].Hosts).To(HaveLen(3))
+			Expect(inventory.Groups["vsis"].Hosts).To(Equal([]string{"169.0.0.1", "10.0.0.2"}))
+			Expect(inventory.Groups["all"].Children).To(Equal([]string{"app", "ungrouped", "vsis"}))
+		})
+		It(`Fail for an unknown inventory`, func() {
+			_, err := schematicsService.ResolveInventory(context.Background(), "missing")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,467 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// EnsureOutcome : what an Ensure operation did.
+type EnsureOutcome string
+
+// Constants associated with the EnsureOutcome type.
+const (
+	// No matching resource existed, so one was created.
+	EnsureOutcomeCreated EnsureOutcome = "created"
+
+	// A matching resource existed and was left as is.
+	EnsureOutcomeUnchanged EnsureOutcome = "unchanged"
+
+	// A matching resource existed and its differing fields were updated.
+	EnsureOutcomeUpdated EnsureOutcome = "updated"
+)
+
+// EnsureOptions : controls how an Ensure operation treats an existing resource.
+type EnsureOptions struct {
+	// If true, the fields of an existing resource that differ from the fields set in the create options
+	// are updated. Only fields that the resource returns in the same form as the create options are compared,
+	// and fields whose values the service does not return as sent (for example secure variable values) always
+	// count as differing. If false, an existing resource is returned as is.
+	Converge bool
+}
+
+// ensureFieldsIgnored are the option fields that are never compared or copied when converging.
+var ensureFieldsIgnored = map[string]bool{
+	"Headers":      true,
+	"XGithubToken": true,
+}
+
+// EnsureWorkspace : Create a workspace unless a matching one exists
+// Look up workspaces with the name, resource group and location of the create options, and create the workspace
+// only if none exists. This makes workspace creation safe to retry, for example after a timeout.
+func (schematics *SchematicsV1) EnsureWorkspace(createWorkspaceOptions *CreateWorkspaceOptions, ensureOptions *EnsureOptions) (result *WorkspaceResponse, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsureWorkspaceWithContext(context.Background(), createWorkspaceOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureWorkspaceWithContext is an alternate form of the EnsureWorkspace method which supports a Context parameter
+func (schematics *SchematicsV1) EnsureWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions, ensureOptions *EnsureOptions) (result *WorkspaceResponse, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createWorkspaceOptions, optionsField(createWorkspaceOptions, func(o *CreateWorkspaceOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "workspace", *createWorkspaceOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]WorkspaceResponse, *int64, error) {
+			list, _, err := schematics.ListWorkspacesWithContext(ctx, &ListWorkspacesOptions{
+				Offset:        &offset,
+				Limit:         &limit,
+				ResourceGroup: createWorkspaceOptions.ResourceGroup,
+				Headers:       createWorkspaceOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Workspaces, list.Count, nil
+		},
+		func(item *WorkspaceResponse) (*string, bool) {
+			return item.ID, matchesEnsureScope(createWorkspaceOptions.Name, createWorkspaceOptions.ResourceGroup, createWorkspaceOptions.Location, item.Name, item.ResourceGroup, item.Location)
+		},
+		func(ctx context.Context) (*WorkspaceResponse, error) {
+			result, _, err := schematics.CreateWorkspaceWithContext(ctx, createWorkspaceOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*WorkspaceResponse, error) {
+			result, _, err := schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &id, Headers: createWorkspaceOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *WorkspaceResponse) (*WorkspaceResponse, bool, error) {
+			updateWorkspaceOptions := schematics.NewUpdateWorkspaceOptions(id)
+			updateWorkspaceOptions.Headers = createWorkspaceOptions.Headers
+			if !convergeFields(createWorkspaceOptions, current, updateWorkspaceOptions, false) {
+				return current, false, nil
+			}
+			result, _, err := schematics.UpdateWorkspaceWithContext(ctx, updateWorkspaceOptions)
+			return result, true, err
+		})
+}
+
+// EnsureAction : Create an action unless a matching one exists
+// Look up actions with the name, resource group and location of the create options, and create the action only if
+// none exists.
+func (schematics *SchematicsV1) EnsureAction(createActionOptions *CreateActionOptions, ensureOptions *EnsureOptions) (result *Action, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsureActionWithContext(context.Background(), createActionOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureActionWithContext is an alternate form of the EnsureAction method which supports a Context parameter
+func (schematics *SchematicsV1) EnsureActionWithContext(ctx context.Context, createActionOptions *CreateActionOptions, ensureOptions *EnsureOptions) (result *Action, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createActionOptions, optionsField(createActionOptions, func(o *CreateActionOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "action", *createActionOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]ActionLite, *int64, error) {
+			list, _, err := schematics.ListActionsWithContext(ctx, &ListActionsOptions{
+				Offset:  &offset,
+				Limit:   &limit,
+				Headers: createActionOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Actions, list.TotalCount, nil
+		},
+		func(item *ActionLite) (*string, bool) {
+			return item.ID, matchesEnsureScope(createActionOptions.Name, createActionOptions.ResourceGroup, createActionOptions.Location, item.Name, item.ResourceGroup, item.Location)
+		},
+		func(ctx context.Context) (*Action, error) {
+			result, _, err := schematics.CreateActionWithContext(ctx, createActionOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*Action, error) {
+			result, _, err := schematics.GetActionWithContext(ctx, &GetActionOptions{ActionID: &id, Headers: createActionOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *Action) (*Action, bool, error) {
+			updateActionOptions := schematics.NewUpdateActionOptions(id)
+			updateActionOptions.Headers = createActionOptions.Headers
+			updateActionOptions.XGithubToken = createActionOptions.XGithubToken
+			if !convergeFields(createActionOptions, current, updateActionOptions, false) {
+				return current, false, nil
+			}
+			result, _, err := schematics.UpdateActionWithContext(ctx, updateActionOptions)
+			return result, true, err
+		})
+}
+
+// EnsureInventory : Create an inventory unless a matching one exists
+// Look up inventories with the name, resource group and location of the create options, and create the inventory
+// only if none exists. When converging, a differing inventory is replaced with the create options.
+func (schematics *SchematicsV1) EnsureInventory(createInventoryOptions *CreateInventoryOptions, ensureOptions *EnsureOptions) (result *InventoryResourceRecord, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsureInventoryWithContext(context.Background(), createInventoryOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureInventoryWithContext is an alternate form of the EnsureInventory method which supports a Context parameter
+func (schematics *SchematicsV1) EnsureInventoryWithContext(ctx context.Context, createInventoryOptions *CreateInventoryOptions, ensureOptions *EnsureOptions) (result *InventoryResourceRecord, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createInventoryOptions, optionsField(createInventoryOptions, func(o *CreateInventoryOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "inventory", *createInventoryOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]InventoryResourceRecord, *int64, error) {
+			list, _, err := schematics.ListInventoriesWithContext(ctx, &ListInventoriesOptions{
+				Offset:  &offset,
+				Limit:   &limit,
+				Headers: createInventoryOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Inventories, list.TotalCount, nil
+		},
+		func(item *InventoryResourceRecord) (*string, bool) {
+			return item.ID, matchesEnsureScope(createInventoryOptions.Name, createInventoryOptions.ResourceGroup, createInventoryOptions.Location, item.Name, item.ResourceGroup, item.Location)
+		},
+		func(ctx context.Context) (*InventoryResourceRecord, error) {
+			result, _, err := schematics.CreateInventoryWithContext(ctx, createInventoryOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*InventoryResourceRecord, error) {
+			result, _, err := schematics.GetInventoryWithContext(ctx, &GetInventoryOptions{InventoryID: &id, Headers: createInventoryOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *InventoryResourceRecord) (*InventoryResourceRecord, bool, error) {
+			replaceInventoryOptions := schematics.NewReplaceInventoryOptions(id)
+			replaceInventoryOptions.Headers = createInventoryOptions.Headers
+			if !convergeFields(createInventoryOptions, current, replaceInventoryOptions, true) {
+				return current, false, nil
+			}
+			result, _, err := schematics.ReplaceInventoryWithContext(ctx, replaceInventoryOptions)
+			return result, true, err
+		})
+}
+
+// EnsureResourceQuery : Create a resource query unless one with the same name exists
+// Resource queries have no resource group or location, so they are matched by name only. When converging, a
+// differing resource query is replaced with the create options.
+func (schematics *SchematicsV1) EnsureResourceQuery(createResourceQueryOptions *CreateResourceQueryOptions, ensureOptions *EnsureOptions) (result *ResourceQueryRecord, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsureResourceQueryWithContext(context.Background(), createResourceQueryOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureResourceQueryWithContext is an alternate form of the EnsureResourceQuery method which supports a Context parameter
+func (schematics *SchematicsV1) EnsureResourceQueryWithContext(ctx context.Context, createResourceQueryOptions *CreateResourceQueryOptions, ensureOptions *EnsureOptions) (result *ResourceQueryRecord, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createResourceQueryOptions, optionsField(createResourceQueryOptions, func(o *CreateResourceQueryOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "resource query", *createResourceQueryOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]ResourceQueryRecord, *int64, error) {
+			list, _, err := schematics.ListResourceQueryWithContext(ctx, &ListResourceQueryOptions{
+				Offset:  &offset,
+				Limit:   &limit,
+				Headers: createResourceQueryOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.ResourceQueries, list.TotalCount, nil
+		},
+		func(item *ResourceQueryRecord) (*string, bool) {
+			return item.ID, matchesEnsureScope(createResourceQueryOptions.Name, nil, nil, item.Name, nil, nil)
+		},
+		func(ctx context.Context) (*ResourceQueryRecord, error) {
+			result, _, err := schematics.CreateResourceQueryWithContext(ctx, createResourceQueryOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*ResourceQueryRecord, error) {
+			result, _, err := schematics.GetResourcesQueryWithContext(ctx, &GetResourcesQueryOptions{QueryID: &id, Headers: createResourceQueryOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *ResourceQueryRecord) (*ResourceQueryRecord, bool, error) {
+			replaceResourcesQueryOptions := schematics.NewReplaceResourcesQueryOptions(id)
+			replaceResourcesQueryOptions.Headers = createResourceQueryOptions.Headers
+			if !convergeFields(createResourceQueryOptions, current, replaceResourcesQueryOptions, true) {
+				return current, false, nil
+			}
+			result, _, err := schematics.ReplaceResourcesQueryWithContext(ctx, replaceResourcesQueryOptions)
+			return result, true, err
+		})
+}
+
+// EnsureAgentData : Register an agent unless a matching one exists
+// Look up agents with the name, resource group and Schematics location of the create options, and register the
+// agent only if none exists. When converging, a differing agent is updated with all fields of the create options.
+func (schematics *SchematicsV1) EnsureAgentData(createAgentDataOptions *CreateAgentDataOptions, ensureOptions *EnsureOptions) (result *AgentData, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsureAgentDataWithContext(context.Background(), createAgentDataOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureAgentDataWithContext is an alternate form of the EnsureAgentData method which supports a Context parameter
+func (schematics *SchematicsV1) EnsureAgentDataWithContext(ctx context.Context, createAgentDataOptions *CreateAgentDataOptions, ensureOptions *EnsureOptions) (result *AgentData, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createAgentDataOptions, optionsField(createAgentDataOptions, func(o *CreateAgentDataOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "agent", *createAgentDataOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]AgentDataLite, *int64, error) {
+			list, _, err := schematics.ListAgentDataWithContext(ctx, &ListAgentDataOptions{
+				Offset:  &offset,
+				Limit:   &limit,
+				Headers: createAgentDataOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Agents, list.TotalCount, nil
+		},
+		func(item *AgentDataLite) (*string, bool) {
+			return item.ID, matchesEnsureScope(createAgentDataOptions.Name, createAgentDataOptions.ResourceGroup, createAgentDataOptions.SchematicsLocation, item.Name, item.ResourceGroup, item.SchematicsLocation)
+		},
+		func(ctx context.Context) (*AgentData, error) {
+			result, _, err := schematics.CreateAgentDataWithContext(ctx, createAgentDataOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*AgentData, error) {
+			result, _, err := schematics.GetAgentDataWithContext(ctx, &GetAgentDataOptions{AgentID: &id, Headers: createAgentDataOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *AgentData) (*AgentData, bool, error) {
+			updateAgentDataOptions := &UpdateAgentDataOptions{
+				AgentID: &id,
+				Headers: createAgentDataOptions.Headers,
+			}
+			if !convergeFields(createAgentDataOptions, current, updateAgentDataOptions, true) {
+				return current, false, nil
+			}
+			result, _, err := schematics.UpdateAgentDataWithContext(ctx, updateAgentDataOptions)
+			return result, true, err
+		})
+}
+
+// EnsurePolicy : Create a policy unless a matching one exists
+// Look up policies with the name, resource group and location of the create options, and create the policy only if
+// none exists.
+func (schematics *SchematicsV1) EnsurePolicy(createPolicyOptions *CreatePolicyOptions, ensureOptions *EnsureOptions) (result *Policy, outcome EnsureOutcome, err error) {
+	result, outcome, err = schematics.EnsurePolicyWithContext(context.Background(), createPolicyOptions, ensureOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsurePolicyWithContext is an alternate form of the EnsurePolicy method which supports a Context parameter
+func (schematics *SchematicsV1) EnsurePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions, ensureOptions *EnsureOptions) (result *Policy, outcome EnsureOutcome, err error) {
+	if err = validateEnsureOptions(createPolicyOptions, optionsField(createPolicyOptions, func(o *CreatePolicyOptions) *string { return o.Name })); err != nil {
+		return
+	}
+	return ensureResource(ctx, "policy", *createPolicyOptions.Name, ensureOptions,
+		func(ctx context.Context, offset int64, limit int64) ([]PolicyLite, *int64, error) {
+			list, _, err := schematics.ListPolicyWithContext(ctx, &ListPolicyOptions{
+				Offset:  &offset,
+				Limit:   &limit,
+				Headers: createPolicyOptions.Headers,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Policies, list.TotalCount, nil
+		},
+		func(item *PolicyLite) (*string, bool) {
+			return item.ID, matchesEnsureScope(createPolicyOptions.Name, createPolicyOptions.ResourceGroup, createPolicyOptions.Location, item.Name, item.ResourceGroup, item.Location)
+		},
+		func(ctx context.Context) (*Policy, error) {
+			result, _, err := schematics.CreatePolicyWithContext(ctx, createPolicyOptions)
+			return result, err
+		},
+		func(ctx context.Context, id string) (*Policy, error) {
+			result, _, err := schematics.GetPolicyWithContext(ctx, &GetPolicyOptions{PolicyID: &id, Headers: createPolicyOptions.Headers})
+			return result, err
+		},
+		func(ctx context.Context, id string, current *Policy) (*Policy, bool, error) {
+			updatePolicyOptions := schematics.NewUpdatePolicyOptions(id)
+			updatePolicyOptions.Headers = createPolicyOptions.Headers
+			if !convergeFields(createPolicyOptions, current, updatePolicyOptions, false) {
+				return current, false, nil
+			}
+			result, _, err := schematics.UpdatePolicyWithContext(ctx, updatePolicyOptions)
+			return result, true, err
+		})
+}
+
+// validateEnsureOptions checks that the create options of an Ensure operation are present and name the resource.
+func validateEnsureOptions(createOptions interface{}, name *string) error {
+	if err := core.ValidateNotNil(createOptions, "createOptions cannot be nil"); err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if name == nil || *name == "" {
+		return core.SDKErrorf(nil, "the create options must specify a name to look up existing resources", "ensure-missing-name", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ensureResource returns the single existing resource matched by match, reading it with get and converging it
+// with update if requested, or creates the resource if no listed resource matches.
+func ensureResource[T any, L any](ctx context.Context, kind string, name string, ensureOptions *EnsureOptions,
+	list func(ctx context.Context, offset int64, limit int64) ([]L, *int64, error),
+	match func(item *L) (id *string, ok bool),
+	create func(ctx context.Context) (*T, error),
+	get func(ctx context.Context, id string) (*T, error),
+	update func(ctx context.Context, id string, current *T) (*T, bool, error)) (result *T, outcome EnsureOutcome, err error) {
+
+	items, err := listAll(ctx, list)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-list-error")
+		return
+	}
+	var ids []string
+	for i := range items {
+		if id, ok := match(&items[i]); ok && id != nil {
+			ids = append(ids, *id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		result, err = create(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "ensure-create-error")
+			return
+		}
+		return result, EnsureOutcomeCreated, nil
+	case 1:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("found %d %s resources named '%s': %v", len(ids), kind, name, ids), "ensure-ambiguous-name", common.GetComponentInfo())
+		return
+	}
+
+	result, err = get(ctx, ids[0])
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-get-error")
+		return
+	}
+	if ensureOptions == nil || !ensureOptions.Converge {
+		return result, EnsureOutcomeUnchanged, nil
+	}
+
+	updated, changed, err := update(ctx, ids[0], result)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "ensure-update-error")
+		return
+	}
+	if !changed {
+		return result, EnsureOutcomeUnchanged, nil
+	}
+	return updated, EnsureOutcomeUpdated, nil
+}
+
+// matchesEnsureScope returns true if a listed resource has the requested name and, where they are requested and
+// known, the requested resource group and location.
+func matchesEnsureScope(name, resourceGroup, location, itemName, itemResourceGroup, itemLocation *string) bool {
+	if itemName == nil || *itemName != *name {
+		return false
+	}
+	if resourceGroup != nil && itemResourceGroup != nil && *resourceGroup != *itemResourceGroup {
+		return false
+	}
+	if location != nil && itemLocation != nil && *location != *itemLocation {
+		return false
+	}
+	return true
+}
+
+// convergeFields copies the fields set in desired (a create options struct) to update (an update options struct)
+// where the same field of current (the existing resource) differs, matching fields by name and type.
+// Fields that current does not have in the same form (e.g. request and response variants of a type) are not compared.
+// If replace is true, all fields set in desired are copied once any field differs, because the update replaces
+// the whole resource. It returns true if any field differs.
+func convergeFields(desired interface{}, current interface{}, update interface{}, replace bool) bool {
+	desiredValue := reflect.ValueOf(desired).Elem()
+	currentValue := reflect.ValueOf(current).Elem()
+	updateValue := reflect.ValueOf(update).Elem()
+
+	changed := false
+	var fields []reflect.Value
+	for i := 0; i < desiredValue.NumField(); i++ {
+		field := desiredValue.Type().Field(i)
+		value := desiredValue.Field(i)
+		target := updateValue.FieldByName(field.Name)
+		if ensureFieldsIgnored[field.Name] || value.IsNil() || !target.IsValid() || target.Type() != field.Type {
+			continue
+		}
+		fields = append(fields, value, target)
+
+		existing := currentValue.FieldByName(field.Name)
+		if !existing.IsValid() || existing.Type() != field.Type || reflect.DeepEqual(value.Interface(), existing.Interface()) {
+			continue
+		}
+		changed = true
+		if !replace {
+			target.Set(value)
+		}
+	}
+	if changed && replace {
+		for i := 0; i < len(fields); i += 2 {
+			fields[i+1].Set(fields[i])
+		}
+	}
+	return changed
+}
@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 ensure operations`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var mutex sync.Mutex
+	var workspaces []map[string]interface{}
+	var inventories []map[string]interface{}
+	var mutations []string
+
+	// store implements list, create, get and update for an in-memory collection.
+	store := func(res http.ResponseWriter, req *http.Request, collection *[]map[string]interface{}, listKey string, countKey string) {
+		res.Header().Set("Content-type", "application/json")
+		segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		if len(segments) == 2 {
+			if req.Method == "GET" {
+				offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+				limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+				end := offset + limit
+				if end > len(*collection) {
+					end = len(*collection)
+				}
+				page := (*collection)[offset:end]
+				body, _ := json.Marshal(map[string]interface{}{listKey: page, countKey: len(*collection), "offset": offset, "limit": limit})
+				res.WriteHeader(200)
+				res.Write(body)
+				return
+			}
+			var item map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&item)).To(Succeed())
+			item["id"] = fmt.Sprintf("us-south.%s.%d", listKey, len(*collection))
+			*collection = append(*collection, item)
+			mutations = append(mutations, "POST")
+			body, _ := json.Marshal(item)
+			res.WriteHeader(201)
+			res.Write(body)
+			return
+		}
+		for _, item := range *collection {
+			if item["id"] != segments[2] {
+				continue
+			}
+			if req.Method != "GET" {
+				var changes map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&changes)).To(Succeed())
+				keys := []string{}
+				for key, value := range changes {
+					item[key] = value
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				mutations = append(mutations, req.Method+" "+strings.Join(keys, ","))
+			}
+			body, _ := json.Marshal(item)
+			res.WriteHeader(200)
+			res.Write(body)
+			return
+		}
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"error": "not found"}`)
+	}
+
+	BeforeEach(func() {
+		workspaces = nil
+		inventories = nil
+		mutations = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch {
+			case strings.HasPrefix(req.URL.Path, "/v1/workspaces"):
+				store(res, req, &workspaces, "workspaces", "count")
+			case strings.HasPrefix(req.URL.Path, "/v2/inventories"):
+				store(res, req, &inventories, "inventories", "total_count")
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newCreateWorkspaceOptions := func(description string) *schematicsv1.CreateWorkspaceOptions {
+		createWorkspaceOptions := schematicsService.NewCreateWorkspaceOptions()
+		createWorkspaceOptions.SetName("app")
+		createWorkspaceOptions.SetResourceGroup("default")
+		createWorkspaceOptions.SetLocation("us-south")
+		createWorkspaceOptions.SetDescription(description)
+		createWorkspaceOptions.SetTags([]string{"env:prod"})
+		return createWorkspaceOptions
+	}
+
+	It(`Create a workspace only once`, func() {
+		result, outcome, err := schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("first"), nil)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeCreated))
+		id := *result.ID
+
+		result, outcome, err = schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("second"), nil)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeUnchanged))
+		Expect(*result.ID).To(Equal(id))
+		Expect(*result.Description).To(Equal("first"))
+		Expect(mutations).To(Equal([]string{"POST"}))
+	})
+	It(`Match by resource group and location across pages`, func() {
+		for i := 0; i < 150; i++ {
+			workspaces = append(workspaces, map[string]interface{}{
+				"id": fmt.Sprintf("us-south.workspaces.%d", i), "name": "app", "resource_group": "other", "location": "us-south",
+			})
+		}
+		workspaces[120]["resource_group"] = "default"
+		workspaces[130]["resource_group"] = "default"
+		workspaces[130]["location"] = "eu-de"
+
+		result, outcome, err := schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("first"), nil)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeUnchanged))
+		Expect(*result.ID).To(Equal("us-south.workspaces.120"))
+		Expect(mutations).To(BeEmpty())
+	})
+	It(`Converge differing fields of an existing workspace`, func() {
+		_, _, err := schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("first"), nil)
+		Expect(err).To(BeNil())
+
+		ensureOptions := &schematicsv1.EnsureOptions{Converge: true}
+		result, outcome, err := schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("second"), ensureOptions)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeUpdated))
+		Expect(*result.Description).To(Equal("second"))
+
+		_, outcome, err = schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("second"), ensureOptions)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeUnchanged))
+		Expect(mutations).To(Equal([]string{"POST", "PATCH description"}))
+	})
+	It(`Replace a differing inventory with all requested fields`, func() {
+		createInventoryOptions := schematicsService.NewCreateInventoryOptions()
+		createInventoryOptions.SetName("hosts")
+		createInventoryOptions.SetLocation("us-south")
+		createInventoryOptions.SetInventoriesIni("[web]\n10.0.0.1")
+		_, outcome, err := schematicsService.EnsureInventory(createInventoryOptions, nil)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeCreated))
+
+		createInventoryOptions.SetInventoriesIni("[web]\n10.0.0.2")
+		result, outcome, err := schematicsService.EnsureInventory(createInventoryOptions, &schematicsv1.EnsureOptions{Converge: true})
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(schematicsv1.EnsureOutcomeUpdated))
+		Expect(*result.InventoriesIni).To(Equal("[web]\n10.0.0.2"))
+		Expect(mutations).To(Equal([]string{"POST", "PUT inventories_ini,location,name"}))
+	})
+	It(`Fail when the name is ambiguous or missing`, func() {
+		for i := 0; i < 2; i++ {
+			workspaces = append(workspaces, map[string]interface{}{"id": fmt.Sprintf("us-south.workspaces.%d", i), "name": "app"})
+		}
+		_, _, err := schematicsService.EnsureWorkspace(newCreateWorkspaceOptions("first"), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("found 2 workspace resources named 'app'"))
+
+		_, _, err = schematicsService.EnsureWorkspace(schematicsService.NewCreateWorkspaceOptions(), nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = schematicsService.EnsurePolicy(nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(mutations).To(BeEmpty())
+	})
+})
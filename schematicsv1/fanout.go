@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// concurrently invokes call for every key concurrently and collects the results and errors by key.
+func concurrently[T any](ctx context.Context, keys []string, call func(ctx context.Context, key string) (T, error)) (map[string]T, map[string]error) {
+	return concurrentlyLimited(ctx, keys, len(keys), call)
+}
+
+// concurrentlyLimited is like concurrently, but invokes call for at most limit keys at once.
+func concurrentlyLimited[T any](ctx context.Context, keys []string, limit int, call func(ctx context.Context, key string) (T, error)) (map[string]T, map[string]error) {
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]T, len(keys))
+	errs := make(map[string]error)
+	workers := make(chan struct{}, max(limit, 1))
+	for _, key := range keys {
+		// Calls that have not started when ctx is done fail with its error instead of being made.
+		err := ctx.Err()
+		if err == nil {
+			select {
+			case workers <- struct{}{}:
+				err = ctx.Err()
+				if err != nil {
+					<-workers
+				}
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		if err != nil {
+			mutex.Lock()
+			errs[key] = err
+			mutex.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			result, err := call(ctx, key)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs[key] = err
+			} else {
+				results[key] = result
+			}
+		}(key)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// summarizeErrors formats errors keyed by region, account, etc. as a single message.
+func summarizeErrors(kind string, errs map[string]error) string {
+	messages := make([]string, 0, len(errs))
+	for _, key := range sortedKeys(errs) {
+		messages = append(messages, fmt.Sprintf("%s: %s", key, errs[key].Error()))
+	}
+	return fmt.Sprintf("operation failed for %d %s(s): %s", len(errs), kind, strings.Join(messages, "; "))
+}
@@ -0,0 +1,24 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+// After regenerating schematics_v1.go from the API definition, every operation must again send its request through
+// invoke and pass its options' headers to common.GetSdkHeadersForRequest; the middleware tests fail until it does.
+
+// Regenerate SchematicsV1API (api.go) and its mock (mocks/schematics_v1_api.go) after adding or changing
+// methods of SchematicsV1.
+//go:generate go run ../internal/mockgen
@@ -0,0 +1,460 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Rules checked by inventory validation.
+const (
+	InventoryRuleDuplicateHost    = "duplicate-host"
+	InventoryRuleInvalidHostname  = "invalid-hostname"
+	InventoryRuleInvalidGroupName = "invalid-group-name"
+	InventoryRuleInvalidVariable  = "invalid-variable-name"
+	InventoryRuleUndefinedChild   = "undefined-child-group"
+	InventoryRuleChildGroupCycle  = "child-group-cycle"
+)
+
+// Section types of an INI inventory; the hosts section of a group has no type.
+const (
+	inventorySectionHosts    = ""
+	inventorySectionVars     = "vars"
+	inventorySectionChildren = "children"
+)
+
+// inventoryHostPattern matches host names, including Ansible ranges (e.g. "web[01:20].example.com") and an
+// optional port.
+var inventoryHostPattern = regexp.MustCompile(`^([A-Za-z0-9_](?:[A-Za-z0-9_.-]|\[[A-Za-z0-9]+:[A-Za-z0-9]+(?::\d+)?\])*)(?::(\d+))?$`)
+
+// inventoryBracketedIPv6Pattern matches an IPv6 address with a port, such as "[2001:db8::1]:2222".
+var inventoryBracketedIPv6Pattern = regexp.MustCompile(`^\[([0-9A-Fa-f:.]+)\]:(\d+)$`)
+
+// inventoryNamePattern matches valid group and variable names.
+var inventoryNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// inventorySectionPattern matches a section header, such as "[web]" or "[web:vars]".
+var inventorySectionPattern = regexp.MustCompile(`^\[([^\[\]:]+)(?::([^\[\]:]+))?\]$`)
+
+// Inventory : an Ansible inventory in the INI format of InventoryResourceRecord.InventoriesIni and
+// Action.TargetsIni. Parse one with ParseInventory and format it with String.
+type Inventory struct {
+	// The hosts listed before the first section, which belong to no group other than "all" and "ungrouped".
+	Hosts []*InventoryHost
+
+	// The groups, in the order of their first section.
+	Groups []*InventoryGroup
+}
+
+// InventoryGroup : a group of an Inventory.
+type InventoryGroup struct {
+	Name string
+
+	// The hosts of the [name] section.
+	Hosts []*InventoryHost
+
+	// The variables of the [name:vars] section.
+	Vars []InventoryVar
+
+	// The names of the groups of the [name:children] section.
+	Children []string
+}
+
+// InventoryHost : a host line of an Inventory, with the variables set on that line.
+type InventoryHost struct {
+	// The host name or pattern, such as "10.0.0.1", "db.example.com:2222" or "web[01:20].example.com".
+	Name string
+
+	Vars []InventoryVar
+}
+
+// InventoryVar : a variable of a host or group. The value is the unquoted value of the INI file.
+type InventoryVar struct {
+	Name  string
+	Value string
+}
+
+// InventoryIssue : a problem found by inventory validation.
+type InventoryIssue struct {
+	// The rule that found the problem (InventoryRule*).
+	Rule string `json:"rule"`
+
+	// The group and host of the problem, if any.
+	Group string `json:"group,omitempty"`
+	Host  string `json:"host,omitempty"`
+
+	Message string `json:"message"`
+}
+
+// InventoryErrors : the problems found by Inventory.Validate.
+type InventoryErrors []InventoryIssue
+
+// Error returns a summary of the problems.
+func (errs InventoryErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, issue := range errs {
+		messages = append(messages, issue.Message)
+	}
+	return fmt.Sprintf("the inventory has %d problem(s): %s", len(errs), strings.Join(messages, "; "))
+}
+
+// ParseInventory parses an Ansible INI inventory. Host lines have the form "name var=value ...", where values
+// may be quoted, and lines of [group:vars] sections have the form "var=value". Lines starting with "#" or ";"
+// are comments. A line in brackets is a section header; other lines starting with "[", such as
+// "[2001:db8::1]:22", are host lines. Repeated sections of a group are merged. ParseInventory checks only the syntax of the
+// inventory; use Validate to check its content.
+func ParseInventory(ini string) (*Inventory, error) {
+	inventory := &Inventory{}
+	var group *InventoryGroup
+	section := inventorySectionHosts
+	for number, line := range strings.Split(ini, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.ContainsAny(line[:1], "#;") {
+			continue
+		}
+		fail := func(format string, args ...interface{}) (*Inventory, error) {
+			message := fmt.Sprintf("line %d: %s", number+1, fmt.Sprintf(format, args...))
+			return nil, core.SDKErrorf(nil, message, "inventory-parse-error", common.GetComponentInfo())
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			match := inventorySectionPattern.FindStringSubmatch(line)
+			if match == nil {
+				return fail("invalid section header '%s'", line)
+			}
+			section = strings.TrimSpace(match[2])
+			if section != inventorySectionHosts && section != inventorySectionVars && section != inventorySectionChildren {
+				return fail("unknown section type '%s'", section)
+			}
+			group = inventory.AddGroup(strings.TrimSpace(match[1]))
+			continue
+		}
+
+		switch section {
+		case inventorySectionHosts:
+			fields, err := splitInventoryLine(line)
+			if err != nil {
+				return fail("%s", err.Error())
+			}
+			host := &InventoryHost{Name: fields[0]}
+			for _, field := range fields[1:] {
+				name, value, ok := strings.Cut(field, "=")
+				if !ok || name == "" {
+					return fail("invalid host variable '%s' of host '%s'", field, host.Name)
+				}
+				host.Vars = append(host.Vars, InventoryVar{Name: name, Value: value})
+			}
+			if group == nil {
+				inventory.Hosts = append(inventory.Hosts, host)
+			} else {
+				group.Hosts = append(group.Hosts, host)
+			}
+		case inventorySectionVars:
+			name, value, ok := strings.Cut(line, "=")
+			name = strings.TrimSpace(name)
+			if !ok || name == "" {
+				return fail("invalid variable '%s' of group '%s'", line, group.Name)
+			}
+			value, err := unquoteInventoryValue(strings.TrimSpace(value))
+			if err != nil {
+				return fail("%s", err.Error())
+			}
+			group.Vars = append(group.Vars, InventoryVar{Name: name, Value: value})
+		case inventorySectionChildren:
+			if strings.ContainsAny(line, " \t=") {
+				return fail("invalid child group '%s' of group '%s'", line, group.Name)
+			}
+			group.Children = append(group.Children, line)
+		}
+	}
+	return inventory, nil
+}
+
+// splitInventoryLine splits a host line into its fields, removing quotes and an end-of-line comment.
+func splitInventoryLine(line string) (fields []string, err error) {
+	var field strings.Builder
+	inField := false
+	var quote rune
+	escaped := false
+	for _, c := range line {
+		switch {
+		case escaped:
+			field.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inField = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				field.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inField = true
+		case c == '#' && !inField:
+			return finishInventoryFields(fields, field.String(), inField, quote != 0 || escaped)
+		case c == ' ' || c == '\t':
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(c)
+			inField = true
+		}
+	}
+	return finishInventoryFields(fields, field.String(), inField, quote != 0 || escaped)
+}
+
+func finishInventoryFields(fields []string, field string, inField bool, unterminated bool) ([]string, error) {
+	if unterminated {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+	if inField {
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// unquoteInventoryValue removes the quotes of a quoted variable value.
+func unquoteInventoryValue(value string) (string, error) {
+	if len(value) < 2 || (value[0] != '"' && value[0] != '\'') {
+		return value, nil
+	}
+	fields, err := splitInventoryLine(value)
+	if err != nil || len(fields) != 1 {
+		return "", fmt.Errorf("invalid quoted value %s", value)
+	}
+	return fields[0], nil
+}
+
+// quoteInventoryValue quotes a variable value if it would not be parsed as itself otherwise.
+func quoteInventoryValue(value string) string {
+	if !strings.ContainsAny(value, " \t\"'#\\") {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// Group returns the group with the specified name, or nil if there is none.
+func (inventory *Inventory) Group(name string) *InventoryGroup {
+	for _, group := range inventory.Groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+// AddGroup returns the group with the specified name, adding an empty group if there is none.
+func (inventory *Inventory) AddGroup(name string) *InventoryGroup {
+	group := inventory.Group(name)
+	if group == nil {
+		group = &InventoryGroup{Name: name}
+		inventory.Groups = append(inventory.Groups, group)
+	}
+	return group
+}
+
+// AddHost adds a host line with the specified variables to the group and returns it.
+func (group *InventoryGroup) AddHost(name string, vars ...InventoryVar) *InventoryHost {
+	host := &InventoryHost{Name: name, Vars: vars}
+	group.Hosts = append(group.Hosts, host)
+	return host
+}
+
+// SetVar sets a variable of the group, replacing its value if it is already set.
+func (group *InventoryGroup) SetVar(name string, value string) {
+	group.Vars = setInventoryVar(group.Vars, name, value)
+}
+
+// SetVar sets a variable of the host line, replacing its value if it is already set.
+func (host *InventoryHost) SetVar(name string, value string) {
+	host.Vars = setInventoryVar(host.Vars, name, value)
+}
+
+func setInventoryVar(vars []InventoryVar, name string, value string) []InventoryVar {
+	for i := range vars {
+		if vars[i].Name == name {
+			vars[i].Value = value
+			return vars
+		}
+	}
+	return append(vars, InventoryVar{Name: name, Value: value})
+}
+
+// String formats the inventory in the INI format, which ParseInventory parses into an equal Inventory. Each
+// group is written as its [name], [name:vars] and [name:children] sections, omitting empty sections other than
+// the [name] section of a group that has no content at all.
+func (inventory *Inventory) String() string {
+	var ini strings.Builder
+	writeHosts := func(hosts []*InventoryHost) {
+		for _, host := range hosts {
+			ini.WriteString(host.Name)
+			for _, variable := range host.Vars {
+				ini.WriteString(" " + variable.Name + "=" + quoteInventoryValue(variable.Value))
+			}
+			ini.WriteString("\n")
+		}
+	}
+	writeSection := func(name string, section string) {
+		if ini.Len() > 0 {
+			ini.WriteString("\n")
+		}
+		if section == inventorySectionHosts {
+			ini.WriteString("[" + name + "]\n")
+		} else {
+			ini.WriteString("[" + name + ":" + section + "]\n")
+		}
+	}
+
+	writeHosts(inventory.Hosts)
+	for _, group := range inventory.Groups {
+		if len(group.Hosts) > 0 || (len(group.Vars) == 0 && len(group.Children) == 0) {
+			writeSection(group.Name, inventorySectionHosts)
+			writeHosts(group.Hosts)
+		}
+		if len(group.Vars) > 0 {
+			writeSection(group.Name, inventorySectionVars)
+			for _, variable := range group.Vars {
+				ini.WriteString(variable.Name + "=" + quoteInventoryValue(variable.Value) + "\n")
+			}
+		}
+		if len(group.Children) > 0 {
+			writeSection(group.Name, inventorySectionChildren)
+			for _, child := range group.Children {
+				ini.WriteString(child + "\n")
+			}
+		}
+	}
+	return ini.String()
+}
+
+// Validate checks the content of the inventory before it is submitted. It returns InventoryErrors if a host is
+// listed twice in the same group, a host name is invalid, a group or variable name is invalid, a child group is
+// not defined, or a group is its own descendant.
+func (inventory *Inventory) Validate() error {
+	var errs InventoryErrors
+	add := func(rule string, group string, host string, format string, args ...interface{}) {
+		errs = append(errs, InventoryIssue{Rule: rule, Group: group, Host: host, Message: fmt.Sprintf(format, args...)})
+	}
+	checkHosts := func(group string, hosts []*InventoryHost) {
+		seen := map[string]bool{}
+		for _, host := range hosts {
+			if !isValidInventoryHost(host.Name) {
+				add(InventoryRuleInvalidHostname, group, host.Name, "invalid host name '%s'", host.Name)
+			}
+			if seen[host.Name] {
+				if group == "" {
+					add(InventoryRuleDuplicateHost, group, host.Name, "host '%s' is listed more than once outside of groups", host.Name)
+				} else {
+					add(InventoryRuleDuplicateHost, group, host.Name, "host '%s' is listed more than once in group '%s'", host.Name, group)
+				}
+			}
+			seen[host.Name] = true
+			for _, variable := range host.Vars {
+				if !inventoryNamePattern.MatchString(variable.Name) {
+					add(InventoryRuleInvalidVariable, group, host.Name, "invalid variable name '%s' of host '%s'", variable.Name, host.Name)
+				}
+			}
+		}
+	}
+
+	checkHosts("", inventory.Hosts)
+	for _, group := range inventory.Groups {
+		if !inventoryNamePattern.MatchString(group.Name) {
+			add(InventoryRuleInvalidGroupName, group.Name, "", "invalid group name '%s'", group.Name)
+		}
+		checkHosts(group.Name, group.Hosts)
+		for _, variable := range group.Vars {
+			if !inventoryNamePattern.MatchString(variable.Name) {
+				add(InventoryRuleInvalidVariable, group.Name, "", "invalid variable name '%s' of group '%s'", variable.Name, group.Name)
+			}
+		}
+		for _, child := range group.Children {
+			if inventory.Group(child) == nil {
+				add(InventoryRuleUndefinedChild, group.Name, "", "child group '%s' of group '%s' is not defined", child, group.Name)
+			}
+		}
+	}
+	for _, group := range inventory.Groups {
+		if inventory.isDescendant(group.Name, group.Name, map[string]bool{}) {
+			add(InventoryRuleChildGroupCycle, group.Name, "", "group '%s' is its own descendant", group.Name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// isDescendant reports whether the group named descendant is a child of the group named ancestor or of one of
+// its descendants.
+func (inventory *Inventory) isDescendant(descendant string, ancestor string, visited map[string]bool) bool {
+	if visited[ancestor] {
+		return false
+	}
+	visited[ancestor] = true
+	group := inventory.Group(ancestor)
+	if group == nil {
+		return false
+	}
+	for _, child := range group.Children {
+		if child == descendant || inventory.isDescendant(descendant, child, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidInventoryHost reports whether name is an IP address, a host name or a host name pattern, with an
+// optional port.
+func isValidInventoryHost(name string) bool {
+	if net.ParseIP(name) != nil {
+		return true
+	}
+	port := ""
+	if match := inventoryBracketedIPv6Pattern.FindStringSubmatch(name); match != nil {
+		if net.ParseIP(match[1]) == nil {
+			return false
+		}
+		port = match[2]
+	} else if match := inventoryHostPattern.FindStringSubmatch(name); match != nil {
+		if strings.HasSuffix(match[1], "-") || strings.Contains(match[1], "..") {
+			return false
+		}
+		port = match[2]
+	} else {
+		return false
+	}
+	if port == "" {
+		return true
+	}
+	number, err := strconv.Atoi(port)
+	return err == nil && number > 0 && number <= 65535
+}
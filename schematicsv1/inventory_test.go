@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 inventories`, func() {
+	const ini = `
+# Hosts outside of groups
+bastion.example.com ansible_user=root
+
+[webserverhost]
+web[01:03].example.com
+10.0.0.5:2222 ansible_user=admin motd="hello world" # the load balancer
+
+; databases
+[dbhost]
+db.example.com ansible_host=172.16.0.9 path='/opt/app data'
+
+[webserverhost:vars]
+http_port = 8080
+greeting = "it's \"quoted\""
+
+[app:children]
+webserverhost
+dbhost
+
+[all:vars]
+ansible_python_interpreter=/usr/bin/python3
+
+[dbhost]
+[2001:db8::1]:22
+`
+
+	It(`Parse an inventory`, func() {
+		inventory, err := schematicsv1.ParseInventory(ini)
+		Expect(err).To(BeNil())
+		Expect(inventory.Hosts).To(Equal([]*schematicsv1.InventoryHost{
+			{Name: "bastion.example.com", Vars: []schematicsv1.InventoryVar{{Name: "ansible_user", Value: "root"}}},
+		}))
+
+		var names []string
+		for _, group := range inventory.Groups {
+			names = append(names, group.Name)
+		}
+		Expect(names).To(Equal([]string{"webserverhost", "dbhost", "app", "all"}))
+
+		web := inventory.Group("webserverhost")
+		Expect(web.Hosts).To(Equal([]*schematicsv1.InventoryHost{
+			{Name: "web[01:03].example.com"},
+			{Name: "10.0.0.5:2222", Vars: []schematicsv1.InventoryVar{{Name: "ansible_user", Value: "admin"}, {Name: "motd", Value: "hello world"}}},
+		}))
+		Expect(web.Vars).To(Equal([]schematicsv1.InventoryVar{{Name: "http_port", Value: "8080"}, {Name: "greeting", Value: `it's "quoted"`}}))
+
+		db := inventory.Group("dbhost")
+		Expect(db.Hosts).To(HaveLen(2))
+		Expect(db.Hosts[0].Vars[1]).To(Equal(schematicsv1.InventoryVar{Name: "path", Value: "/opt/app data"}))
+		Expect(db.Hosts[1].Name).To(Equal("[2001:db8::1]:22"))
+		Expect(inventory.Group("app").Children).To(Equal([]string{"webserverhost", "dbhost"}))
+		Expect(inventory.Group("all").Vars).To(HaveLen(1))
+		Expect(inventory.Group("missing")).To(BeNil())
+
+		Expect(inventory.Validate()).To(Succeed())
+	})
+	It(`Format an inventory that parses into an equal inventory`, func() {
+		inventory, err := schematicsv1.ParseInventory(ini)
+		Expect(err).To(BeNil())
+		formatted := inventory.String()
+		Expect(formatted).To(Equal(`bastion.example.com ansible_user=root
+
+[webserverhost]
+web[01:03].example.com
+10.0.0.5:2222 ansible_user=admin motd="hello world"
+
+[webserverhost:vars]
+http_port=8080
+greeting="it's \"quoted\""
+
+[dbhost]
+db.example.com ansible_host=172.16.0.9 path="/opt/app data"
+[2001:db8::1]:22
+
+[app:children]
+webserverhost
+dbhost
+
+[all:vars]
+ansible_python_interpreter=/usr/bin/python3
+`))
+		reparsed, err := schematicsv1.ParseInventory(formatted)
+		Expect(err).To(BeNil())
+		Expect(reparsed).To(Equal(inventory))
+	})
+	It(`Build an inventory`, func() {
+		inventory := &schematicsv1.Inventory{}
+		web := inventory.AddGroup("web")
+		host := web.AddHost("10.0.0.1")
+		host.SetVar("ansible_user", "root")
+		host.SetVar("ansible_user", "admin")
+		web.SetVar("path", `C:\temp`)
+		inventory.AddGroup("empty")
+		Expect(inventory.AddGroup("web")).To(BeIdenticalTo(web))
+
+		Expect(inventory.String()).To(Equal("[web]\n10.0.0.1 ansible_user=admin\n\n[web:vars]\npath=\"C:\\\\temp\"\n\n[empty]\n"))
+		reparsed, err := schematicsv1.ParseInventory(inventory.String())
+		Expect(err).To(BeNil())
+		Expect(reparsed).To(Equal(inventory))
+	})
+	It(`Report syntax errors with their line`, func() {
+		for text, message := range map[string]string{
+			"[web:db:vars]":                "line 1: invalid section header '[web:db:vars]'",
+			"[web:hosts]":                  "line 1: unknown section type 'hosts'",
+			"[web]\nhost1 ansible_user":    "line 2: invalid host variable 'ansible_user' of host 'host1'",
+			"[web]\nhost1 motd=\"unclosed": "line 2: unterminated quote or escape",
+			"[web:vars]\nhttp_port":        "line 2: invalid variable 'http_port' of group 'web'",
+			"[web:children]\ndb extra":     "line 2: invalid child group 'db extra' of group 'web'",
+			"\n\n[web:vars]\nx='unclosed":  "line 4: invalid quoted value 'unclosed",
+		} {
+			_, err := schematicsv1.ParseInventory(text)
+			Expect(err).ToNot(BeNil(), text)
+			Expect(err.Error()).To(Equal(message))
+		}
+	})
+	It(`Validate an inventory`, func() {
+		inventory, err := schematicsv1.ParseInventory(`
+host_1
+host_1
+[web]
+web1.example.com
+web1.example.com
+-bad.example.com
+bad..example.com
+host:99999
+10.0.0.1 bad-var=1
+[web:children]
+db
+loop
+[loop:children]
+web
+[bad-group]
+[2001:db8::zz]:22
+`)
+		Expect(err).To(BeNil())
+		err = inventory.Validate()
+		Expect(err).ToNot(BeNil())
+		issues := err.(schematicsv1.InventoryErrors)
+		var rules []string
+		for _, issue := range issues {
+			rules = append(rules, issue.Rule)
+		}
+		Expect(rules).To(Equal([]string{
+			schematicsv1.InventoryRuleDuplicateHost,
+			schematicsv1.InventoryRuleDuplicateHost,
+			schematicsv1.InventoryRuleInvalidHostname,
+			schematicsv1.InventoryRuleInvalidHostname,
+			schematicsv1.InventoryRuleInvalidHostname,
+			schematicsv1.InventoryRuleInvalidVariable,
+			schematicsv1.InventoryRuleUndefinedChild,
+			schematicsv1.InventoryRuleInvalidGroupName,
+			schematicsv1.InventoryRuleInvalidHostname,
+			schematicsv1.InventoryRuleChildGroupCycle,
+			schematicsv1.InventoryRuleChildGroupCycle,
+		}))
+		Expect(issues[1]).To(Equal(schematicsv1.InventoryIssue{
+			Rule:    schematicsv1.InventoryRuleDuplicateHost,
+			Group:   "web",
+			Host:    "web1.example.com",
+			Message: "host 'web1.example.com' is listed more than once in group 'web'",
+		}))
+		Expect(err.Error()).To(HavePrefix("the inventory has 11 problem(s): host 'host_1' is listed more than once outside of groups; "))
+		Expect(err.Error()).To(ContainSubstring("child group 'db' of group 'web' is not defined"))
+		Expect(err.Error()).To(ContainSubstring("group 'loop' is its own descendant"))
+	})
+})
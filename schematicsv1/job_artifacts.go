@@ -0,0 +1,294 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// JobArtifactsManifestFile is the name of the manifest written by DownloadJobArtifacts.
+const JobArtifactsManifestFile = "manifest.json"
+
+// DefaultMaxJobTemplateRepoSize is the maximum size of the files unpacked from a job's template repository in
+// total, if DownloadJobArtifactsOptions.MaxTemplateRepoSize is not set.
+const DefaultMaxJobTemplateRepoSize = 1 << 30
+
+// Job artifacts, such as state files, can hold secrets, so they are readable by their owner only.
+const (
+	jobArtifactDirMode  = 0700
+	jobArtifactFileMode = 0600
+)
+
+// jobArtifactFileNames are the names of the main file of each job file type.
+// The template repository is unpacked into a directory of that name instead.
+var jobArtifactFileNames = map[string]string{
+	GetJobFilesOptions_FileType_LogFile:      "job.log",
+	GetJobFilesOptions_FileType_PlanJSON:     "plan.json",
+	GetJobFilesOptions_FileType_ReadmeFile:   "README.md",
+	GetJobFilesOptions_FileType_StateFile:    "terraform.tfstate",
+	GetJobFilesOptions_FileType_TemplateRepo: "template_repo",
+}
+
+// DownloadJobArtifactsOptions : the DownloadJobArtifacts options.
+type DownloadJobArtifactsOptions struct {
+	// The file types to download (GetJobFilesOptions_FileType_*). Defaults to all file types.
+	FileTypes []string
+
+	// The maximum size of the files unpacked from the template repository in total; DefaultMaxJobTemplateRepoSize
+	// if 0.
+	MaxTemplateRepoSize int64
+
+	// Custom request headers added to every operation.
+	Headers map[string]string
+}
+
+// JobArtifactsManifest : the description of the files downloaded by DownloadJobArtifacts.
+type JobArtifactsManifest struct {
+	JobID        string    `json:"job_id"`
+	JobName      string    `json:"job_name,omitempty"`
+	DownloadedAt time.Time `json:"downloaded_at"`
+
+	// The downloaded file types, sorted by file type.
+	Artifacts []JobArtifact `json:"artifacts"`
+
+	// The requested file types that the job has no file of.
+	Missing []string `json:"missing,omitempty"`
+}
+
+// JobArtifact : the files of a job file type.
+type JobArtifact struct {
+	FileType  string               `json:"file_type"`
+	UpdatedAt *strfmt.DateTime     `json:"updated_at,omitempty"`
+	Summary   []JobFileDataSummary `json:"summary,omitempty"`
+
+	// The written files, as slash-separated paths relative to the download directory.
+	Files []string `json:"files"`
+}
+
+// JobArtifactErrors : the errors returned for individual file types by DownloadJobArtifacts, keyed by file type.
+type JobArtifactErrors map[string]error
+
+// Error returns a summary of the file type errors.
+func (errs JobArtifactErrors) Error() string {
+	return summarizeErrors("file type", errs)
+}
+
+// DownloadJobArtifacts downloads the files of a job into dir, fetching the file types concurrently.
+//
+// The main file of each type is written with a conventional name (job.log, plan.json, README.md,
+// terraform.tfstate) and the additional files of each type are written under a directory named after the type.
+// The template repository is unpacked into the template_repo directory. A manifest describing the files, with
+// the update time and summary of each type, is written to JobArtifactsManifestFile. File types that the job has
+// no file of are listed in JobArtifactsManifest.Missing. If some file types fail to download, the others are
+// still written and a JobArtifactErrors is returned with the manifest.
+//
+// Files are written with mode 0600 and directories with mode 0700, because state files and logs can hold secrets.
+// Files that already exist in dir are replaced, and the mode of existing directories below dir is changed.
+func (schematics *SchematicsV1) DownloadJobArtifacts(ctx context.Context, jobID string, dir string, options *DownloadJobArtifactsOptions) (manifest *JobArtifactsManifest, err error) {
+	if options == nil {
+		options = &DownloadJobArtifactsOptions{}
+	}
+	fileTypes := options.FileTypes
+	if len(fileTypes) == 0 {
+		fileTypes = sortedKeys(jobArtifactFileNames)
+	}
+	for _, fileType := range fileTypes {
+		if _, ok := jobArtifactFileNames[fileType]; !ok {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported job file type '%s'", fileType), "job-artifacts-file-type-error", common.GetComponentInfo())
+		}
+	}
+	maxSize := options.MaxTemplateRepoSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxJobTemplateRepoSize
+	}
+	if err = os.MkdirAll(dir, jobArtifactDirMode); err != nil {
+		return nil, core.SDKErrorf(err, "", "job-artifacts-write-error", common.GetComponentInfo())
+	}
+
+	files, errs := concurrently(ctx, fileTypes, func(ctx context.Context, fileType string) (*JobFileData, error) {
+		jobFileData, response, err := schematics.GetJobFilesWithContext(ctx, &GetJobFilesOptions{JobID: &jobID, FileType: &fileType, Headers: options.Headers})
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, core.RepurposeSDKProblem(err, "job-artifacts-download-error")
+		}
+		return jobFileData, nil
+	})
+
+	manifest = &JobArtifactsManifest{JobID: jobID, DownloadedAt: time.Now().UTC()}
+	for _, fileType := range sortedKeys(files) {
+		jobFileData := files[fileType]
+		if jobFileData == nil {
+			manifest.Missing = append(manifest.Missing, fileType)
+			continue
+		}
+		if jobFileData.JobName != nil {
+			manifest.JobName = *jobFileData.JobName
+		}
+		artifact, writeErr := writeJobArtifact(dir, fileType, jobFileData, maxSize)
+		if writeErr != nil {
+			errs[fileType] = writeErr
+			continue
+		}
+		manifest.Artifacts = append(manifest.Artifacts, artifact)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, core.SDKErrorf(err, "", "job-artifacts-write-error", common.GetComponentInfo())
+	}
+	if err = writeArtifactFile(dir, JobArtifactsManifestFile, data); err != nil {
+		return manifest, err
+	}
+	if len(errs) > 0 {
+		return manifest, JobArtifactErrors(errs)
+	}
+	return manifest, nil
+}
+
+// writeJobArtifact writes the files of a job file type into dir. The files unpacked from a template repository are
+// limited to maxSize bytes in total.
+func writeJobArtifact(dir string, fileType string, jobFileData *JobFileData, maxSize int64) (artifact JobArtifact, err error) {
+	artifact = JobArtifact{
+		FileType:  fileType,
+		UpdatedAt: jobFileData.UpdatedAt,
+		Summary:   jobFileData.Summary,
+	}
+	name := jobArtifactFileNames[fileType]
+	if jobFileData.FileContent != nil {
+		if fileType == GetJobFilesOptions_FileType_TemplateRepo {
+			var unpacked []string
+			if unpacked, err = unpackTemplateRepo(dir, name, *jobFileData.FileContent, maxSize); err != nil {
+				return
+			}
+			artifact.Files = append(artifact.Files, unpacked...)
+		} else {
+			if err = writeArtifactFile(dir, name, []byte(*jobFileData.FileContent)); err != nil {
+				return
+			}
+			artifact.Files = append(artifact.Files, name)
+		}
+	}
+	for _, additional := range jobFileData.AdditionalFiles {
+		if additional.FileName == nil || additional.FileContent == nil {
+			continue
+		}
+		additionalName := path.Join(fileType, *additional.FileName)
+		if err = writeArtifactFile(dir, additionalName, []byte(*additional.FileContent)); err != nil {
+			return
+		}
+		artifact.Files = append(artifact.Files, additionalName)
+	}
+	return
+}
+
+// unpackTemplateRepo unpacks the content of a template_repo file, a tar or gzipped tar file that may be base64
+// encoded, into the subdirectory name of dir, and returns the paths of the unpacked files. The unpacked files are
+// limited to maxSize bytes in total.
+func unpackTemplateRepo(dir string, name string, content string, maxSize int64) (files []string, err error) {
+	data, decodeErr := base64.StdEncoding.DecodeString(content)
+	if decodeErr != nil {
+		data = []byte(content)
+	}
+	var reader io.Reader = bytes.NewReader(data)
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		if reader, err = gzip.NewReader(reader); err != nil {
+			return nil, core.SDKErrorf(err, "the template repository is not a valid gzip file", "job-artifacts-unpack-error", common.GetComponentInfo())
+		}
+	}
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, core.SDKErrorf(err, "the template repository is not a valid tar file", "job-artifacts-unpack-error", common.GetComponentInfo())
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		fileName := path.Join(name, header.Name)
+		if !strings.HasPrefix(fileName, name+"/") {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("the template repository contains an invalid path '%s'", header.Name), "job-artifacts-unpack-error", common.GetComponentInfo())
+		}
+		data, err := io.ReadAll(io.LimitReader(tarReader, maxSize+1))
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "job-artifacts-unpack-error", common.GetComponentInfo())
+		}
+		if int64(len(data)) > maxSize {
+			return nil, core.SDKErrorf(nil, "the template repository exceeds the maximum template repository size", "job-artifacts-size-error", common.GetComponentInfo())
+		}
+		maxSize -= int64(len(data))
+		if err = writeArtifactFile(dir, fileName, data); err != nil {
+			return nil, err
+		}
+		files = append(files, fileName)
+	}
+}
+
+// writeArtifactFile writes data to the slash-separated path name within dir, creating its parent directories.
+// Names that would leave dir are rejected. An existing file is replaced rather than overwritten, so that the new
+// file has jobArtifactFileMode, and the mode of existing parent directories below dir is set to jobArtifactDirMode.
+func writeArtifactFile(dir string, name string, data []byte) error {
+	cleaned := path.Clean("/" + name)
+	if cleaned != "/"+name {
+		return core.SDKErrorf(nil, fmt.Sprintf("invalid file name '%s'", name), "job-artifacts-write-error", common.GetComponentInfo())
+	}
+	fileName := filepath.Join(dir, filepath.FromSlash(cleaned))
+	err := os.MkdirAll(filepath.Dir(fileName), jobArtifactDirMode)
+	for parent := path.Dir(name); err == nil && parent != "."; parent = path.Dir(parent) {
+		err = os.Chmod(filepath.Join(dir, filepath.FromSlash(parent)), jobArtifactDirMode)
+	}
+	if err == nil {
+		if err = os.Remove(fileName); os.IsNotExist(err) {
+			err = nil
+		}
+	}
+	var file *os.File
+	if err == nil {
+		file, err = os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, jobArtifactFileMode)
+	}
+	if err == nil {
+		_, err = file.Write(data)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "job-artifacts-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 job artifacts`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var root string
+	var dir string
+	var templateRepo string
+
+	newTarGz := func(files map[string]string) string {
+		buffer := &bytes.Buffer{}
+		gzipWriter := gzip.NewWriter(buffer)
+		tarWriter := tar.NewWriter(gzipWriter)
+		for name, content := range files {
+			Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+			_, err := tarWriter.Write([]byte(content))
+			Expect(err).To(BeNil())
+		}
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+		return base64.StdEncoding.EncodeToString(buffer.Bytes())
+	}
+
+	BeforeEach(func() {
+		var err error
+		root, err = os.MkdirTemp("", "job-artifacts")
+		Expect(err).To(BeNil())
+		dir = filepath.Join(root, "artifacts")
+		templateRepo = newTarGz(map[string]string{"main.tf": "resource {}", "modules/vpc/vpc.tf": "module {}"})
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/v2/jobs/job1/files"))
+			res.Header().Set("Content-type", "application/json")
+			fileType := req.URL.Query().Get("file_type")
+			file := map[string]interface{}{"job_id": "job1", "job_name": "apply", "file_type": fileType, "updated_at": "2024-05-01T10:00:00.000Z"}
+			switch fileType {
+			case "log_file":
+				file["file_content"] = "job log"
+				file["additional_files"] = []map[string]string{{"file_name": "template-1.log", "file_content": "template log"}}
+			case "state_file":
+				file["file_content"] = `{"version": 4}`
+				file["summary"] = []map[string]string{{"name": "resources", "type": "number", "value": "3"}}
+			case "template_repo":
+				file["file_content"] = templateRepo
+			case "readme_file":
+				res.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+				return
+			case "plan_json":
+				res.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(res, `{"errors": [{"message": "failed"}]}`)
+				return
+			}
+			Expect(json.NewEncoder(res).Encode(file)).To(Succeed())
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(root)
+	})
+
+	readFile := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		Expect(err).To(BeNil())
+		return string(data)
+	}
+
+	It(`Download all file types`, func() {
+		manifest, err := schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err).To(BeAssignableToTypeOf(schematicsv1.JobArtifactErrors{}))
+		Expect(err.(schematicsv1.JobArtifactErrors)).To(HaveKey("plan_json"))
+		Expect(err.(schematicsv1.JobArtifactErrors)).To(HaveLen(1))
+
+		Expect(manifest.JobName).To(Equal("apply"))
+		Expect(manifest.Missing).To(Equal([]string{"readme_file"}))
+		Expect(manifest.Artifacts).To(HaveLen(3))
+		Expect(manifest.Artifacts[0].FileType).To(Equal("log_file"))
+		Expect(manifest.Artifacts[0].Files).To(Equal([]string{"job.log", "log_file/template-1.log"}))
+		Expect(manifest.Artifacts[0].UpdatedAt.String()).To(Equal("2024-05-01T10:00:00.000Z"))
+		Expect(manifest.Artifacts[1].FileType).To(Equal("state_file"))
+		Expect(*manifest.Artifacts[1].Summary[0].Value).To(Equal("3"))
+		Expect(manifest.Artifacts[2].Files).To(ConsistOf("template_repo/main.tf", "template_repo/modules/vpc/vpc.tf"))
+
+		Expect(readFile("job.log")).To(Equal("job log"))
+		Expect(readFile("log_file/template-1.log")).To(Equal("template log"))
+		Expect(readFile("terraform.tfstate")).To(Equal(`{"version": 4}`))
+		Expect(readFile("template_repo/modules/vpc/vpc.tf")).To(Equal("module {}"))
+
+		written := &schematicsv1.JobArtifactsManifest{}
+		Expect(json.Unmarshal([]byte(readFile(schematicsv1.JobArtifactsManifestFile)), written)).To(Succeed())
+		Expect(written.Artifacts).To(HaveLen(3))
+
+		if runtime.GOOS != "windows" {
+			for name, mode := range map[string]os.FileMode{
+				"":                                    0700,
+				"log_file":                            0700,
+				"template_repo/modules":               0700,
+				"terraform.tfstate":                   0600,
+				"log_file/template-1.log":             0600,
+				"template_repo/modules/vpc/vpc.tf":    0600,
+				schematicsv1.JobArtifactsManifestFile: 0600,
+			} {
+				info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+				Expect(err).To(BeNil())
+				Expect(info.Mode().Perm()).To(Equal(mode), name)
+			}
+		}
+	})
+	It(`Download selected file types`, func() {
+		manifest, err := schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, &schematicsv1.DownloadJobArtifactsOptions{
+			FileTypes: []string{schematicsv1.GetJobFilesOptions_FileType_StateFile},
+		})
+		Expect(err).To(BeNil())
+		Expect(manifest.Artifacts).To(HaveLen(1))
+		Expect(manifest.Missing).To(BeEmpty())
+		_, statErr := os.Stat(filepath.Join(dir, "job.log"))
+		Expect(os.IsNotExist(statErr)).To(BeTrue())
+
+		_, err = schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, &schematicsv1.DownloadJobArtifactsOptions{FileTypes: []string{"cost_json"}})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Restrict the mode of files and directories that already exist`, func() {
+		if runtime.GOOS == "windows" {
+			Skip("file modes are not supported on Windows")
+		}
+		Expect(os.MkdirAll(filepath.Join(dir, "log_file"), 0755)).To(Succeed())
+		Expect(os.Chmod(filepath.Join(dir, "log_file"), 0755)).To(Succeed())
+		for _, name := range []string{"job.log", "log_file/template-1.log", schematicsv1.JobArtifactsManifestFile} {
+			fileName := filepath.Join(dir, filepath.FromSlash(name))
+			Expect(os.WriteFile(fileName, []byte("old content that is longer than the new content"), 0644)).To(Succeed())
+			Expect(os.Chmod(fileName, 0644)).To(Succeed())
+		}
+
+		_, err := schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, &schematicsv1.DownloadJobArtifactsOptions{
+			FileTypes: []string{schematicsv1.GetJobFilesOptions_FileType_LogFile},
+		})
+		Expect(err).To(BeNil())
+		Expect(readFile("job.log")).To(Equal("job log"))
+		Expect(readFile("log_file/template-1.log")).To(Equal("template log"))
+		for name, mode := range map[string]os.FileMode{
+			"log_file":                            0700,
+			"job.log":                             0600,
+			"log_file/template-1.log":             0600,
+			schematicsv1.JobArtifactsManifestFile: 0600,
+		} {
+			info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+			Expect(err).To(BeNil())
+			Expect(info.Mode().Perm()).To(Equal(mode), name)
+		}
+	})
+	It(`Limit the size of the unpacked template repository`, func() {
+		templateRepo = newTarGz(map[string]string{"main.tf": "resource {}", "variables.tf": "variable {}"})
+		options := &schematicsv1.DownloadJobArtifactsOptions{
+			FileTypes:           []string{schematicsv1.GetJobFilesOptions_FileType_TemplateRepo},
+			MaxTemplateRepoSize: 22,
+		}
+		manifest, err := schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, options)
+		Expect(err).To(BeNil())
+		Expect(manifest.Artifacts[0].Files).To(HaveLen(2))
+
+		options.MaxTemplateRepoSize = 21
+		_, err = schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("exceeds the maximum template repository size"))
+	})
+	It(`Reject template repository paths outside the directory`, func() {
+		templateRepo = newTarGz(map[string]string{"../escape.tf": "resource {}"})
+		_, err := schematicsService.DownloadJobArtifacts(context.Background(), "job1", dir, &schematicsv1.DownloadJobArtifactsOptions{
+			FileTypes: []string{schematicsv1.GetJobFilesOptions_FileType_TemplateRepo},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid path"))
+		_, statErr := os.Stat(filepath.Join(dir, "..", "escape.tf"))
+		Expect(os.IsNotExist(statErr)).To(BeTrue())
+	})
+})
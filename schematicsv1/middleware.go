@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation : describes a single SchematicsV1 API call as it travels through the middleware chain.
+type Operation struct {
+	// The operationId as defined in the API definition (e.g. "GetWorkspace").
+	ID string
+
+	// The options struct passed to the service method (e.g. *GetWorkspaceOptions).
+	Options interface{}
+
+	// The outgoing HTTP request. Middleware may modify it, for example to add headers.
+	Request *http.Request
+
+	// A pointer to the variable that receives the decoded result of the service method, or nil
+	// if the method has no result.
+	result interface{}
+}
+
+// readOnlyOperations are the operations that do not change any resources even though
+// they are not sent as GET requests.
+var readOnlyOperations = map[string]bool{
+	"ProcessTemplateMetaData": true,
+	"ExecuteResourceQuery":    true,
+}
+
+// IsReadOnly returns true if the operation only reads resources.
+func (operation *Operation) IsReadOnly() bool {
+	if operation.Request != nil && (operation.Request.Method == http.MethodGet || operation.Request.Method == http.MethodHead) {
+		return true
+	}
+	return readOnlyOperations[operation.ID]
+}
+
+// RoundTripFunc : performs an Operation and returns its response.
+// On success, the decoded result of the operation is available as response.Result.
+type RoundTripFunc func(ctx context.Context, operation *Operation) (response *core.DetailedResponse, err error)
+
+// Middleware : wraps a RoundTripFunc with additional behavior.
+// A middleware may inspect or modify the operation before calling next, inspect the
+// response after next returns, or return its own response without calling next at all.
+// The chain is assembled for every operation, so state shared across operations must be created
+// outside of the Middleware function.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middleware to the chain invoked for every operation of this service instance.
+// Middleware registered first is outermost, i.e. it sees the operation first and the response last.
+// Use is not safe to call concurrently with in-flight requests.
+func (schematics *SchematicsV1) Use(middleware ...Middleware) {
+	chain := make([]Middleware, 0, len(schematics.middleware)+len(middleware))
+	chain = append(chain, schematics.middleware...)
+	schematics.middleware = append(chain, middleware...)
+}
+
+// invoke sends a request built by a service method through the middleware chain.
+// The send function performs the HTTP exchange and decodes the response into the method's result.
+// If a middleware produces its own response, its Result is copied into result (when the types match)
+// so that callers of the service method receive it as the method's return value.
+func (schematics *SchematicsV1) invoke(ctx context.Context, operationID string, options interface{}, request *http.Request, result interface{}, send func(request *http.Request) (*core.DetailedResponse, error)) (response *core.DetailedResponse, err error) {
+	operation := &Operation{
+		ID:      operationID,
+		Options: options,
+		result:  result,
+	}
+	// The operation travels with the request's context so that HTTP transports, such as the one installed by
+	// EnableRateLimiting, can tell which operation each request, including each retry, belongs to.
+	ctx = context.WithValue(ctx, operationContextKey{}, operation)
+	operation.Request = request.WithContext(ctx)
+	if len(schematics.middleware) == 0 {
+		return send(operation.Request)
+	}
+
+	next := RoundTripFunc(func(ctx context.Context, operation *Operation) (*core.DetailedResponse, error) {
+		request := operation.Request
+		if request.Context() != ctx {
+			request = request.WithContext(ctx)
+		}
+		return send(request)
+	})
+	for i := len(schematics.middleware) - 1; i >= 0; i-- {
+		next = schematics.middleware[i](next)
+	}
+
+	response, err = next(ctx, operation)
+	if result != nil && response != nil && !core.IsNil(response.Result) {
+		setOperationResult(result, response.Result)
+	}
+	return
+}
+
+// operationContextKey : the context key of the Operation that a request belongs to.
+type operationContextKey struct{}
+
+// operationFromContext returns the Operation that the request with the specified context belongs to, or nil.
+func operationFromContext(ctx context.Context) *Operation {
+	operation, _ := ctx.Value(operationContextKey{}).(*Operation)
+	return operation
+}
+
+// setOperationResult stores value in the variable pointed to by result if that variable is still unset
+// and value has a compatible type.
+func setOperationResult(result interface{}, value interface{}) {
+	target := reflect.ValueOf(result)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return
+	}
+	target = target.Elem()
+	if !target.IsZero() {
+		return
+	}
+	source := reflect.ValueOf(value)
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+	}
+}
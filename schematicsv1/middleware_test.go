@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/common"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 middleware`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	var lastHeaders http.Header
+
+	BeforeEach(func() {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requestCount++
+			lastHeaders = req.Header.Clone()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "GET":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "testString", "name": "Name", "tags": ["env:prod"]}`)
+			case "DELETE":
+				res.WriteHeader(204)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"activityid": "ActivityID"}`)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *schematicsv1.SchematicsV1 {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(schematicsService).ToNot(BeNil())
+		return schematicsService
+	}
+
+	It(`Invoke middleware in registration order with operation details and decoded result`, func() {
+		schematicsService := newService()
+
+		var calls []string
+		var seenResult interface{}
+		schematicsService.Use(
+			func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+				return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+					calls = append(calls, "outer:"+operation.ID)
+					Expect(operation.Options).To(BeAssignableToTypeOf(&schematicsv1.GetWorkspaceOptions{}))
+					response, err := next(ctx, operation)
+					seenResult = response.Result
+					return response, err
+				}
+			},
+			func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+				return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+					calls = append(calls, "inner:"+operation.ID)
+					operation.Request.Header.Set("X-Audit", "yes")
+					return next(ctx, operation)
+				}
+			},
+		)
+
+		getWorkspaceOptionsModel := schematicsService.NewGetWorkspaceOptions("testString")
+		result, response, operationErr := schematicsService.GetWorkspace(getWorkspaceOptionsModel)
+		Expect(operationErr).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(*result.Name).To(Equal("Name"))
+
+		Expect(calls).To(Equal([]string{"outer:GetWorkspace", "inner:GetWorkspace"}))
+		Expect(seenResult).To(Equal(result))
+		Expect(lastHeaders.Get("X-Audit")).To(Equal("yes"))
+	})
+	It(`Allow middleware to short-circuit an operation`, func() {
+		schematicsService := newService()
+
+		guardErr := errors.New("destroy is not allowed on production workspaces")
+		schematicsService.Use(func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+			return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+				if operation.ID == "DestroyWorkspaceCommand" {
+					return nil, guardErr
+				}
+				return next(ctx, operation)
+			}
+		})
+
+		destroyWorkspaceCommandOptionsModel := schematicsService.NewDestroyWorkspaceCommandOptions("testString", "testString")
+		result, response, operationErr := schematicsService.DestroyWorkspaceCommand(destroyWorkspaceCommandOptionsModel)
+		Expect(operationErr).To(Equal(guardErr))
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+		Expect(requestCount).To(Equal(0))
+	})
+	It(`Return a result supplied by middleware`, func() {
+		schematicsService := newService()
+
+		cached := &schematicsv1.WorkspaceResponse{ID: core.StringPtr("cached")}
+		schematicsService.Use(func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+			return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+				return &core.DetailedResponse{StatusCode: 200, Result: cached}, nil
+			}
+		})
+
+		result, response, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(result).To(Equal(cached))
+		Expect(requestCount).To(Equal(0))
+	})
+	It(`Invoke middleware for operations without a result`, func() {
+		schematicsService := newService()
+
+		var operationID string
+		schematicsService.Use(func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+			return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+				operationID = operation.ID
+				return next(ctx, operation)
+			}
+		})
+
+		response, operationErr := schematicsService.DeleteAction(schematicsService.NewDeleteActionOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		Expect(operationID).To(Equal("DeleteAction"))
+	})
+	It(`Keep a request ID supplied by the caller`, func() {
+		schematicsService := newService()
+		common.SetRequestIDHeaders(common.HeaderNameRequestID, common.HeaderNameCorrelationID)
+		defer common.ResetSdkHeaders()
+
+		getWorkspaceOptions := schematicsService.NewGetWorkspaceOptions("testString")
+		_, _, err := schematicsService.GetWorkspace(getWorkspaceOptions)
+		Expect(err).To(BeNil())
+		generated := lastHeaders.Get(common.HeaderNameRequestID)
+		Expect(generated).ToNot(BeEmpty())
+		Expect(lastHeaders.Get(common.HeaderNameCorrelationID)).To(Equal(generated))
+
+		getWorkspaceOptions.SetHeaders(map[string]string{"x-request-id": "caller-id"})
+		_, _, err = schematicsService.GetWorkspace(getWorkspaceOptions)
+		Expect(err).To(BeNil())
+		Expect(lastHeaders.Values(common.HeaderNameRequestID)).To(Equal([]string{"caller-id"}))
+		Expect(lastHeaders.Get(common.HeaderNameCorrelationID)).To(Equal("caller-id"))
+	})
+	It(`Do not share middleware added to a clone`, func() {
+		schematicsService := newService()
+		clone := schematicsService.Clone()
+
+		var invoked bool
+		clone.Use(func(next schematicsv1.RoundTripFunc) schematicsv1.RoundTripFunc {
+			return func(ctx context.Context, operation *schematicsv1.Operation) (*core.DetailedResponse, error) {
+				invoked = true
+				return next(ctx, operation)
+			}
+		})
+
+		_, _, operationErr := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(invoked).To(BeFalse())
+
+		_, _, operationErr = clone.GetWorkspace(clone.NewGetWorkspaceOptions("testString"))
+		Expect(operationErr).To(BeNil())
+		Expect(invoked).To(BeTrue())
+	})
+	It(`Send every generated operation through the middleware chain`, func() {
+		// schematics_v1.go is generated; this fails if a regeneration drops the calls that the middleware chain and
+		// the request headers rely on.
+		file, err := parser.ParseFile(token.NewFileSet(), "schematics_v1.go", nil, 0)
+		Expect(err).To(BeNil())
+		operations := 0
+		for _, declaration := range file.Decls {
+			function, ok := declaration.(*ast.FuncDecl)
+			if !ok || function.Recv == nil || !strings.HasSuffix(function.Name.Name, "WithContext") {
+				continue
+			}
+			operationID := strconv.Quote(strings.TrimSuffix(function.Name.Name, "WithContext"))
+			options := function.Type.Params.List[len(function.Type.Params.List)-1].Names[0].Name
+			var invoked, headers bool
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				if call, ok := node.(*ast.CallExpr); ok {
+					switch types.ExprString(call.Fun) {
+					case "schematics.invoke":
+						invoked = len(call.Args) == 6 && types.ExprString(call.Args[1]) == operationID && types.ExprString(call.Args[2]) == options
+					case "common.GetSdkHeadersForRequest":
+						headers = len(call.Args) == 4 && types.ExprString(call.Args[2]) == operationID && types.ExprString(call.Args[3]) == options+".Headers"
+					}
+				}
+				return true
+			})
+			Expect(invoked).To(BeTrue(), "%s does not send its request through schematics.invoke", function.Name.Name)
+			Expect(headers).To(BeTrue(), "%s does not pass its headers to common.GetSdkHeadersForRequest", function.Name.Name)
+			operations++
+		}
+		Expect(operations).To(BeNumerically(">=", 85))
+	})
+})
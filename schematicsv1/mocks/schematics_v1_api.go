@@ -0,0 +1,3040 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// SchematicsV1API : a mock implementation of schematicsv1.SchematicsV1API.
+//
+// Script expectations with On and Return (see github.com/stretchr/testify/mock). Return accepts either the
+// method's return values or a function with the method's signature that computes them from the arguments.
+type SchematicsV1API struct {
+	mock.Mock
+}
+
+// NewSchematicsV1API : constructs a SchematicsV1API whose expectations are asserted when the test completes.
+func NewSchematicsV1API(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SchematicsV1API {
+	m := &SchematicsV1API{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Verify that SchematicsV1API implements schematicsv1.SchematicsV1API.
+var _ schematicsv1.SchematicsV1API = (*SchematicsV1API)(nil)
+
+// ListSchematicsLocation provides a mock function for SchematicsV1API.ListSchematicsLocation.
+func (_m *SchematicsV1API) ListSchematicsLocation(listSchematicsLocationOptions *schematicsv1.ListSchematicsLocationOptions) ([]schematicsv1.SchematicsLocations, *core.DetailedResponse, error) {
+	ret := _m.Called(listSchematicsLocationOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchematicsLocation")
+	}
+	if rf, ok := ret.Get(0).(func(listSchematicsLocationOptions *schematicsv1.ListSchematicsLocationOptions) ([]schematicsv1.SchematicsLocations, *core.DetailedResponse, error)); ok {
+		return rf(listSchematicsLocationOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.SchematicsLocations)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListSchematicsLocationWithContext provides a mock function for SchematicsV1API.ListSchematicsLocationWithContext.
+func (_m *SchematicsV1API) ListSchematicsLocationWithContext(ctx context.Context, listSchematicsLocationOptions *schematicsv1.ListSchematicsLocationOptions) ([]schematicsv1.SchematicsLocations, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listSchematicsLocationOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchematicsLocationWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listSchematicsLocationOptions *schematicsv1.ListSchematicsLocationOptions) ([]schematicsv1.SchematicsLocations, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listSchematicsLocationOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.SchematicsLocations)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListLocations provides a mock function for SchematicsV1API.ListLocations.
+func (_m *SchematicsV1API) ListLocations(listLocationsOptions *schematicsv1.ListLocationsOptions) (*schematicsv1.SchematicsLocationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listLocationsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocations")
+	}
+	if rf, ok := ret.Get(0).(func(listLocationsOptions *schematicsv1.ListLocationsOptions) (*schematicsv1.SchematicsLocationsList, *core.DetailedResponse, error)); ok {
+		return rf(listLocationsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.SchematicsLocationsList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListLocationsWithContext provides a mock function for SchematicsV1API.ListLocationsWithContext.
+func (_m *SchematicsV1API) ListLocationsWithContext(ctx context.Context, listLocationsOptions *schematicsv1.ListLocationsOptions) (*schematicsv1.SchematicsLocationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listLocationsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocationsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listLocationsOptions *schematicsv1.ListLocationsOptions) (*schematicsv1.SchematicsLocationsList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listLocationsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.SchematicsLocationsList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceGroup provides a mock function for SchematicsV1API.ListResourceGroup.
+func (_m *SchematicsV1API) ListResourceGroup(listResourceGroupOptions *schematicsv1.ListResourceGroupOptions) ([]schematicsv1.ResourceGroupResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceGroupOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListResourceGroup")
+	}
+	if rf, ok := ret.Get(0).(func(listResourceGroupOptions *schematicsv1.ListResourceGroupOptions) ([]schematicsv1.ResourceGroupResponse, *core.DetailedResponse, error)); ok {
+		return rf(listResourceGroupOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.ResourceGroupResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceGroupWithContext provides a mock function for SchematicsV1API.ListResourceGroupWithContext.
+func (_m *SchematicsV1API) ListResourceGroupWithContext(ctx context.Context, listResourceGroupOptions *schematicsv1.ListResourceGroupOptions) ([]schematicsv1.ResourceGroupResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceGroupOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListResourceGroupWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listResourceGroupOptions *schematicsv1.ListResourceGroupOptions) ([]schematicsv1.ResourceGroupResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listResourceGroupOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.ResourceGroupResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSchematicsVersion provides a mock function for SchematicsV1API.GetSchematicsVersion.
+func (_m *SchematicsV1API) GetSchematicsVersion(getSchematicsVersionOptions *schematicsv1.GetSchematicsVersionOptions) (*schematicsv1.VersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getSchematicsVersionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchematicsVersion")
+	}
+	if rf, ok := ret.Get(0).(func(getSchematicsVersionOptions *schematicsv1.GetSchematicsVersionOptions) (*schematicsv1.VersionResponse, *core.DetailedResponse, error)); ok {
+		return rf(getSchematicsVersionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.VersionResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSchematicsVersionWithContext provides a mock function for SchematicsV1API.GetSchematicsVersionWithContext.
+func (_m *SchematicsV1API) GetSchematicsVersionWithContext(ctx context.Context, getSchematicsVersionOptions *schematicsv1.GetSchematicsVersionOptions) (*schematicsv1.VersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSchematicsVersionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchematicsVersionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getSchematicsVersionOptions *schematicsv1.GetSchematicsVersionOptions) (*schematicsv1.VersionResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getSchematicsVersionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.VersionResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ProcessTemplateMetaData provides a mock function for SchematicsV1API.ProcessTemplateMetaData.
+func (_m *SchematicsV1API) ProcessTemplateMetaData(processTemplateMetaDataOptions *schematicsv1.ProcessTemplateMetaDataOptions) (*schematicsv1.TemplateMetaDataResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(processTemplateMetaDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessTemplateMetaData")
+	}
+	if rf, ok := ret.Get(0).(func(processTemplateMetaDataOptions *schematicsv1.ProcessTemplateMetaDataOptions) (*schematicsv1.TemplateMetaDataResponse, *core.DetailedResponse, error)); ok {
+		return rf(processTemplateMetaDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateMetaDataResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ProcessTemplateMetaDataWithContext provides a mock function for SchematicsV1API.ProcessTemplateMetaDataWithContext.
+func (_m *SchematicsV1API) ProcessTemplateMetaDataWithContext(ctx context.Context, processTemplateMetaDataOptions *schematicsv1.ProcessTemplateMetaDataOptions) (*schematicsv1.TemplateMetaDataResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, processTemplateMetaDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessTemplateMetaDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, processTemplateMetaDataOptions *schematicsv1.ProcessTemplateMetaDataOptions) (*schematicsv1.TemplateMetaDataResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, processTemplateMetaDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateMetaDataResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListWorkspaces provides a mock function for SchematicsV1API.ListWorkspaces.
+func (_m *SchematicsV1API) ListWorkspaces(listWorkspacesOptions *schematicsv1.ListWorkspacesOptions) (*schematicsv1.WorkspaceResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(listWorkspacesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaces")
+	}
+	if rf, ok := ret.Get(0).(func(listWorkspacesOptions *schematicsv1.ListWorkspacesOptions) (*schematicsv1.WorkspaceResponseList, *core.DetailedResponse, error)); ok {
+		return rf(listWorkspacesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListWorkspacesWithContext provides a mock function for SchematicsV1API.ListWorkspacesWithContext.
+func (_m *SchematicsV1API) ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *schematicsv1.ListWorkspacesOptions) (*schematicsv1.WorkspaceResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listWorkspacesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspacesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listWorkspacesOptions *schematicsv1.ListWorkspacesOptions) (*schematicsv1.WorkspaceResponseList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listWorkspacesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateWorkspace provides a mock function for SchematicsV1API.CreateWorkspace.
+func (_m *SchematicsV1API) CreateWorkspace(createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(createWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateWorkspaceWithContext provides a mock function for SchematicsV1API.CreateWorkspaceWithContext.
+func (_m *SchematicsV1API) CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspace provides a mock function for SchematicsV1API.GetWorkspace.
+func (_m *SchematicsV1API) GetWorkspace(getWorkspaceOptions *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceOptions *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceWithContext provides a mock function for SchematicsV1API.GetWorkspaceWithContext.
+func (_m *SchematicsV1API) GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceOptions *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceWorkspace provides a mock function for SchematicsV1API.ReplaceWorkspace.
+func (_m *SchematicsV1API) ReplaceWorkspace(replaceWorkspaceOptions *schematicsv1.ReplaceWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(replaceWorkspaceOptions *schematicsv1.ReplaceWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(replaceWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceWorkspaceWithContext provides a mock function for SchematicsV1API.ReplaceWorkspaceWithContext.
+func (_m *SchematicsV1API) ReplaceWorkspaceWithContext(ctx context.Context, replaceWorkspaceOptions *schematicsv1.ReplaceWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, replaceWorkspaceOptions *schematicsv1.ReplaceWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, replaceWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteWorkspace provides a mock function for SchematicsV1API.DeleteWorkspace.
+func (_m *SchematicsV1API) DeleteWorkspace(deleteWorkspaceOptions *schematicsv1.DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(deleteWorkspaceOptions *schematicsv1.DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(deleteWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteWorkspaceWithContext provides a mock function for SchematicsV1API.DeleteWorkspaceWithContext.
+func (_m *SchematicsV1API) DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *schematicsv1.DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteWorkspaceOptions *schematicsv1.DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateWorkspace provides a mock function for SchematicsV1API.UpdateWorkspace.
+func (_m *SchematicsV1API) UpdateWorkspace(updateWorkspaceOptions *schematicsv1.UpdateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(updateWorkspaceOptions *schematicsv1.UpdateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(updateWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateWorkspaceWithContext provides a mock function for SchematicsV1API.UpdateWorkspaceWithContext.
+func (_m *SchematicsV1API) UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *schematicsv1.UpdateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateWorkspaceOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateWorkspaceOptions *schematicsv1.UpdateWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateWorkspaceOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceReadme provides a mock function for SchematicsV1API.GetWorkspaceReadme.
+func (_m *SchematicsV1API) GetWorkspaceReadme(getWorkspaceReadmeOptions *schematicsv1.GetWorkspaceReadmeOptions) (*schematicsv1.TemplateReadme, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceReadmeOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceReadme")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceReadmeOptions *schematicsv1.GetWorkspaceReadmeOptions) (*schematicsv1.TemplateReadme, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceReadmeOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateReadme)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceReadmeWithContext provides a mock function for SchematicsV1API.GetWorkspaceReadmeWithContext.
+func (_m *SchematicsV1API) GetWorkspaceReadmeWithContext(ctx context.Context, getWorkspaceReadmeOptions *schematicsv1.GetWorkspaceReadmeOptions) (*schematicsv1.TemplateReadme, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceReadmeOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceReadmeWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceReadmeOptions *schematicsv1.GetWorkspaceReadmeOptions) (*schematicsv1.TemplateReadme, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceReadmeOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateReadme)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TemplateRepoUpload provides a mock function for SchematicsV1API.TemplateRepoUpload.
+func (_m *SchematicsV1API) TemplateRepoUpload(templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(templateRepoUploadOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateRepoUpload")
+	}
+	if rf, ok := ret.Get(0).(func(templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(templateRepoUploadOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TemplateRepoUploadWithContext provides a mock function for SchematicsV1API.TemplateRepoUploadWithContext.
+func (_m *SchematicsV1API) TemplateRepoUploadWithContext(ctx context.Context, templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, templateRepoUploadOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateRepoUploadWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, templateRepoUploadOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceInputs provides a mock function for SchematicsV1API.GetWorkspaceInputs.
+func (_m *SchematicsV1API) GetWorkspaceInputs(getWorkspaceInputsOptions *schematicsv1.GetWorkspaceInputsOptions) (*schematicsv1.TemplateValues, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceInputs")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceInputsOptions *schematicsv1.GetWorkspaceInputsOptions) (*schematicsv1.TemplateValues, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateValues)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceInputsWithContext provides a mock function for SchematicsV1API.GetWorkspaceInputsWithContext.
+func (_m *SchematicsV1API) GetWorkspaceInputsWithContext(ctx context.Context, getWorkspaceInputsOptions *schematicsv1.GetWorkspaceInputsOptions) (*schematicsv1.TemplateValues, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceInputsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceInputsOptions *schematicsv1.GetWorkspaceInputsOptions) (*schematicsv1.TemplateValues, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateValues)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceWorkspaceInputs provides a mock function for SchematicsV1API.ReplaceWorkspaceInputs.
+func (_m *SchematicsV1API) ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions *schematicsv1.ReplaceWorkspaceInputsOptions) (*schematicsv1.UserValues, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceWorkspaceInputs")
+	}
+	if rf, ok := ret.Get(0).(func(replaceWorkspaceInputsOptions *schematicsv1.ReplaceWorkspaceInputsOptions) (*schematicsv1.UserValues, *core.DetailedResponse, error)); ok {
+		return rf(replaceWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.UserValues)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceWorkspaceInputsWithContext provides a mock function for SchematicsV1API.ReplaceWorkspaceInputsWithContext.
+func (_m *SchematicsV1API) ReplaceWorkspaceInputsWithContext(ctx context.Context, replaceWorkspaceInputsOptions *schematicsv1.ReplaceWorkspaceInputsOptions) (*schematicsv1.UserValues, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceWorkspaceInputsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, replaceWorkspaceInputsOptions *schematicsv1.ReplaceWorkspaceInputsOptions) (*schematicsv1.UserValues, *core.DetailedResponse, error)); ok {
+		return rf(ctx, replaceWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.UserValues)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAllWorkspaceInputs provides a mock function for SchematicsV1API.GetAllWorkspaceInputs.
+func (_m *SchematicsV1API) GetAllWorkspaceInputs(getAllWorkspaceInputsOptions *schematicsv1.GetAllWorkspaceInputsOptions) (*schematicsv1.WorkspaceTemplateValuesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getAllWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWorkspaceInputs")
+	}
+	if rf, ok := ret.Get(0).(func(getAllWorkspaceInputsOptions *schematicsv1.GetAllWorkspaceInputsOptions) (*schematicsv1.WorkspaceTemplateValuesResponse, *core.DetailedResponse, error)); ok {
+		return rf(getAllWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceTemplateValuesResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAllWorkspaceInputsWithContext provides a mock function for SchematicsV1API.GetAllWorkspaceInputsWithContext.
+func (_m *SchematicsV1API) GetAllWorkspaceInputsWithContext(ctx context.Context, getAllWorkspaceInputsOptions *schematicsv1.GetAllWorkspaceInputsOptions) (*schematicsv1.WorkspaceTemplateValuesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAllWorkspaceInputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWorkspaceInputsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getAllWorkspaceInputsOptions *schematicsv1.GetAllWorkspaceInputsOptions) (*schematicsv1.WorkspaceTemplateValuesResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getAllWorkspaceInputsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceTemplateValuesResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceInputMetadata provides a mock function for SchematicsV1API.GetWorkspaceInputMetadata.
+func (_m *SchematicsV1API) GetWorkspaceInputMetadata(getWorkspaceInputMetadataOptions *schematicsv1.GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceInputMetadataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceInputMetadata")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceInputMetadataOptions *schematicsv1.GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceInputMetadataOptions)
+	}
+	r0, _ := ret.Get(0).([]map[string]interface{})
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceInputMetadataWithContext provides a mock function for SchematicsV1API.GetWorkspaceInputMetadataWithContext.
+func (_m *SchematicsV1API) GetWorkspaceInputMetadataWithContext(ctx context.Context, getWorkspaceInputMetadataOptions *schematicsv1.GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceInputMetadataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceInputMetadataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceInputMetadataOptions *schematicsv1.GetWorkspaceInputMetadataOptions) ([]map[string]interface{}, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceInputMetadataOptions)
+	}
+	r0, _ := ret.Get(0).([]map[string]interface{})
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceOutputs provides a mock function for SchematicsV1API.GetWorkspaceOutputs.
+func (_m *SchematicsV1API) GetWorkspaceOutputs(getWorkspaceOutputsOptions *schematicsv1.GetWorkspaceOutputsOptions) ([]schematicsv1.OutputValuesInner, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceOutputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceOutputs")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceOutputsOptions *schematicsv1.GetWorkspaceOutputsOptions) ([]schematicsv1.OutputValuesInner, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceOutputsOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.OutputValuesInner)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceOutputsWithContext provides a mock function for SchematicsV1API.GetWorkspaceOutputsWithContext.
+func (_m *SchematicsV1API) GetWorkspaceOutputsWithContext(ctx context.Context, getWorkspaceOutputsOptions *schematicsv1.GetWorkspaceOutputsOptions) ([]schematicsv1.OutputValuesInner, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceOutputsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceOutputsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceOutputsOptions *schematicsv1.GetWorkspaceOutputsOptions) ([]schematicsv1.OutputValuesInner, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceOutputsOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.OutputValuesInner)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceResources provides a mock function for SchematicsV1API.GetWorkspaceResources.
+func (_m *SchematicsV1API) GetWorkspaceResources(getWorkspaceResourcesOptions *schematicsv1.GetWorkspaceResourcesOptions) ([]schematicsv1.TemplateResources, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceResourcesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceResources")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceResourcesOptions *schematicsv1.GetWorkspaceResourcesOptions) ([]schematicsv1.TemplateResources, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceResourcesOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.TemplateResources)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceResourcesWithContext provides a mock function for SchematicsV1API.GetWorkspaceResourcesWithContext.
+func (_m *SchematicsV1API) GetWorkspaceResourcesWithContext(ctx context.Context, getWorkspaceResourcesOptions *schematicsv1.GetWorkspaceResourcesOptions) ([]schematicsv1.TemplateResources, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceResourcesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceResourcesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceResourcesOptions *schematicsv1.GetWorkspaceResourcesOptions) ([]schematicsv1.TemplateResources, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceResourcesOptions)
+	}
+	r0, _ := ret.Get(0).([]schematicsv1.TemplateResources)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceState provides a mock function for SchematicsV1API.GetWorkspaceState.
+func (_m *SchematicsV1API) GetWorkspaceState(getWorkspaceStateOptions *schematicsv1.GetWorkspaceStateOptions) (*schematicsv1.StateStoreResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceStateOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceState")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceStateOptions *schematicsv1.GetWorkspaceStateOptions) (*schematicsv1.StateStoreResponseList, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceStateOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.StateStoreResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceStateWithContext provides a mock function for SchematicsV1API.GetWorkspaceStateWithContext.
+func (_m *SchematicsV1API) GetWorkspaceStateWithContext(ctx context.Context, getWorkspaceStateOptions *schematicsv1.GetWorkspaceStateOptions) (*schematicsv1.StateStoreResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceStateOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceStateWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceStateOptions *schematicsv1.GetWorkspaceStateOptions) (*schematicsv1.StateStoreResponseList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceStateOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.StateStoreResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceTemplateState provides a mock function for SchematicsV1API.GetWorkspaceTemplateState.
+func (_m *SchematicsV1API) GetWorkspaceTemplateState(getWorkspaceTemplateStateOptions *schematicsv1.GetWorkspaceTemplateStateOptions) (*schematicsv1.TemplateStateStore, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceTemplateStateOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceTemplateState")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceTemplateStateOptions *schematicsv1.GetWorkspaceTemplateStateOptions) (*schematicsv1.TemplateStateStore, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceTemplateStateOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateStateStore)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceTemplateStateWithContext provides a mock function for SchematicsV1API.GetWorkspaceTemplateStateWithContext.
+func (_m *SchematicsV1API) GetWorkspaceTemplateStateWithContext(ctx context.Context, getWorkspaceTemplateStateOptions *schematicsv1.GetWorkspaceTemplateStateOptions) (*schematicsv1.TemplateStateStore, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceTemplateStateOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceTemplateStateWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceTemplateStateOptions *schematicsv1.GetWorkspaceTemplateStateOptions) (*schematicsv1.TemplateStateStore, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceTemplateStateOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateStateStore)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceActivityLogs provides a mock function for SchematicsV1API.GetWorkspaceActivityLogs.
+func (_m *SchematicsV1API) GetWorkspaceActivityLogs(getWorkspaceActivityLogsOptions *schematicsv1.GetWorkspaceActivityLogsOptions) (*schematicsv1.WorkspaceActivityLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceActivityLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceActivityLogs")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceActivityLogsOptions *schematicsv1.GetWorkspaceActivityLogsOptions) (*schematicsv1.WorkspaceActivityLogs, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceActivityLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityLogs)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceActivityLogsWithContext provides a mock function for SchematicsV1API.GetWorkspaceActivityLogsWithContext.
+func (_m *SchematicsV1API) GetWorkspaceActivityLogsWithContext(ctx context.Context, getWorkspaceActivityLogsOptions *schematicsv1.GetWorkspaceActivityLogsOptions) (*schematicsv1.WorkspaceActivityLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceActivityLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceActivityLogsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceActivityLogsOptions *schematicsv1.GetWorkspaceActivityLogsOptions) (*schematicsv1.WorkspaceActivityLogs, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceActivityLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityLogs)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceLogUrls provides a mock function for SchematicsV1API.GetWorkspaceLogUrls.
+func (_m *SchematicsV1API) GetWorkspaceLogUrls(getWorkspaceLogUrlsOptions *schematicsv1.GetWorkspaceLogUrlsOptions) (*schematicsv1.LogStoreResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceLogUrlsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceLogUrls")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceLogUrlsOptions *schematicsv1.GetWorkspaceLogUrlsOptions) (*schematicsv1.LogStoreResponseList, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceLogUrlsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.LogStoreResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceLogUrlsWithContext provides a mock function for SchematicsV1API.GetWorkspaceLogUrlsWithContext.
+func (_m *SchematicsV1API) GetWorkspaceLogUrlsWithContext(ctx context.Context, getWorkspaceLogUrlsOptions *schematicsv1.GetWorkspaceLogUrlsOptions) (*schematicsv1.LogStoreResponseList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceLogUrlsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceLogUrlsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceLogUrlsOptions *schematicsv1.GetWorkspaceLogUrlsOptions) (*schematicsv1.LogStoreResponseList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceLogUrlsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.LogStoreResponseList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateLogs provides a mock function for SchematicsV1API.GetTemplateLogs.
+func (_m *SchematicsV1API) GetTemplateLogs(getTemplateLogsOptions *schematicsv1.GetTemplateLogsOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(getTemplateLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateLogs")
+	}
+	if rf, ok := ret.Get(0).(func(getTemplateLogsOptions *schematicsv1.GetTemplateLogsOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(getTemplateLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateLogsWithContext provides a mock function for SchematicsV1API.GetTemplateLogsWithContext.
+func (_m *SchematicsV1API) GetTemplateLogsWithContext(ctx context.Context, getTemplateLogsOptions *schematicsv1.GetTemplateLogsOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTemplateLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateLogsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getTemplateLogsOptions *schematicsv1.GetTemplateLogsOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getTemplateLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateActivityLog provides a mock function for SchematicsV1API.GetTemplateActivityLog.
+func (_m *SchematicsV1API) GetTemplateActivityLog(getTemplateActivityLogOptions *schematicsv1.GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(getTemplateActivityLogOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateActivityLog")
+	}
+	if rf, ok := ret.Get(0).(func(getTemplateActivityLogOptions *schematicsv1.GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(getTemplateActivityLogOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateActivityLogWithContext provides a mock function for SchematicsV1API.GetTemplateActivityLogWithContext.
+func (_m *SchematicsV1API) GetTemplateActivityLogWithContext(ctx context.Context, getTemplateActivityLogOptions *schematicsv1.GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTemplateActivityLogOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateActivityLogWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getTemplateActivityLogOptions *schematicsv1.GetTemplateActivityLogOptions) (*string, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getTemplateActivityLogOptions)
+	}
+	r0, _ := ret.Get(0).(*string)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActions provides a mock function for SchematicsV1API.ListActions.
+func (_m *SchematicsV1API) ListActions(listActionsOptions *schematicsv1.ListActionsOptions) (*schematicsv1.ActionList, *core.DetailedResponse, error) {
+	ret := _m.Called(listActionsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListActions")
+	}
+	if rf, ok := ret.Get(0).(func(listActionsOptions *schematicsv1.ListActionsOptions) (*schematicsv1.ActionList, *core.DetailedResponse, error)); ok {
+		return rf(listActionsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ActionList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionsWithContext provides a mock function for SchematicsV1API.ListActionsWithContext.
+func (_m *SchematicsV1API) ListActionsWithContext(ctx context.Context, listActionsOptions *schematicsv1.ListActionsOptions) (*schematicsv1.ActionList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listActionsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListActionsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listActionsOptions *schematicsv1.ListActionsOptions) (*schematicsv1.ActionList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listActionsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ActionList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAction provides a mock function for SchematicsV1API.CreateAction.
+func (_m *SchematicsV1API) CreateAction(createActionOptions *schematicsv1.CreateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(createActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAction")
+	}
+	if rf, ok := ret.Get(0).(func(createActionOptions *schematicsv1.CreateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(createActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionWithContext provides a mock function for SchematicsV1API.CreateActionWithContext.
+func (_m *SchematicsV1API) CreateActionWithContext(ctx context.Context, createActionOptions *schematicsv1.CreateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createActionOptions *schematicsv1.CreateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAction provides a mock function for SchematicsV1API.GetAction.
+func (_m *SchematicsV1API) GetAction(getActionOptions *schematicsv1.GetActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(getActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAction")
+	}
+	if rf, ok := ret.Get(0).(func(getActionOptions *schematicsv1.GetActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(getActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionWithContext provides a mock function for SchematicsV1API.GetActionWithContext.
+func (_m *SchematicsV1API) GetActionWithContext(ctx context.Context, getActionOptions *schematicsv1.GetActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getActionOptions *schematicsv1.GetActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAction provides a mock function for SchematicsV1API.DeleteAction.
+func (_m *SchematicsV1API) DeleteAction(deleteActionOptions *schematicsv1.DeleteActionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAction")
+	}
+	if rf, ok := ret.Get(0).(func(deleteActionOptions *schematicsv1.DeleteActionOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteActionOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteActionWithContext provides a mock function for SchematicsV1API.DeleteActionWithContext.
+func (_m *SchematicsV1API) DeleteActionWithContext(ctx context.Context, deleteActionOptions *schematicsv1.DeleteActionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteActionOptions *schematicsv1.DeleteActionOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteActionOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateAction provides a mock function for SchematicsV1API.UpdateAction.
+func (_m *SchematicsV1API) UpdateAction(updateActionOptions *schematicsv1.UpdateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(updateActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAction")
+	}
+	if rf, ok := ret.Get(0).(func(updateActionOptions *schematicsv1.UpdateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(updateActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateActionWithContext provides a mock function for SchematicsV1API.UpdateActionWithContext.
+func (_m *SchematicsV1API) UpdateActionWithContext(ctx context.Context, updateActionOptions *schematicsv1.UpdateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateActionOptions *schematicsv1.UpdateActionOptions) (*schematicsv1.Action, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadTemplateTarAction provides a mock function for SchematicsV1API.UploadTemplateTarAction.
+func (_m *SchematicsV1API) UploadTemplateTarAction(uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(uploadTemplateTarActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UploadTemplateTarAction")
+	}
+	if rf, ok := ret.Get(0).(func(uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(uploadTemplateTarActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadTemplateTarActionWithContext provides a mock function for SchematicsV1API.UploadTemplateTarActionWithContext.
+func (_m *SchematicsV1API) UploadTemplateTarActionWithContext(ctx context.Context, uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, uploadTemplateTarActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UploadTemplateTarActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, uploadTemplateTarActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListWorkspaceActivities provides a mock function for SchematicsV1API.ListWorkspaceActivities.
+func (_m *SchematicsV1API) ListWorkspaceActivities(listWorkspaceActivitiesOptions *schematicsv1.ListWorkspaceActivitiesOptions) (*schematicsv1.WorkspaceActivities, *core.DetailedResponse, error) {
+	ret := _m.Called(listWorkspaceActivitiesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaceActivities")
+	}
+	if rf, ok := ret.Get(0).(func(listWorkspaceActivitiesOptions *schematicsv1.ListWorkspaceActivitiesOptions) (*schematicsv1.WorkspaceActivities, *core.DetailedResponse, error)); ok {
+		return rf(listWorkspaceActivitiesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivities)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListWorkspaceActivitiesWithContext provides a mock function for SchematicsV1API.ListWorkspaceActivitiesWithContext.
+func (_m *SchematicsV1API) ListWorkspaceActivitiesWithContext(ctx context.Context, listWorkspaceActivitiesOptions *schematicsv1.ListWorkspaceActivitiesOptions) (*schematicsv1.WorkspaceActivities, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listWorkspaceActivitiesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaceActivitiesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listWorkspaceActivitiesOptions *schematicsv1.ListWorkspaceActivitiesOptions) (*schematicsv1.WorkspaceActivities, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listWorkspaceActivitiesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivities)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceActivity provides a mock function for SchematicsV1API.GetWorkspaceActivity.
+func (_m *SchematicsV1API) GetWorkspaceActivity(getWorkspaceActivityOptions *schematicsv1.GetWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivity, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceActivityOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceActivity")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceActivityOptions *schematicsv1.GetWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivity, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceActivityOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivity)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceActivityWithContext provides a mock function for SchematicsV1API.GetWorkspaceActivityWithContext.
+func (_m *SchematicsV1API) GetWorkspaceActivityWithContext(ctx context.Context, getWorkspaceActivityOptions *schematicsv1.GetWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivity, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceActivityOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceActivityWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceActivityOptions *schematicsv1.GetWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivity, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceActivityOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivity)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteWorkspaceActivity provides a mock function for SchematicsV1API.DeleteWorkspaceActivity.
+func (_m *SchematicsV1API) DeleteWorkspaceActivity(deleteWorkspaceActivityOptions *schematicsv1.DeleteWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteWorkspaceActivityOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceActivity")
+	}
+	if rf, ok := ret.Get(0).(func(deleteWorkspaceActivityOptions *schematicsv1.DeleteWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error)); ok {
+		return rf(deleteWorkspaceActivityOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityApplyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteWorkspaceActivityWithContext provides a mock function for SchematicsV1API.DeleteWorkspaceActivityWithContext.
+func (_m *SchematicsV1API) DeleteWorkspaceActivityWithContext(ctx context.Context, deleteWorkspaceActivityOptions *schematicsv1.DeleteWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteWorkspaceActivityOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceActivityWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteWorkspaceActivityOptions *schematicsv1.DeleteWorkspaceActivityOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteWorkspaceActivityOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityApplyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RunWorkspaceCommands provides a mock function for SchematicsV1API.RunWorkspaceCommands.
+func (_m *SchematicsV1API) RunWorkspaceCommands(runWorkspaceCommandsOptions *schematicsv1.RunWorkspaceCommandsOptions) (*schematicsv1.WorkspaceActivityCommandResult, *core.DetailedResponse, error) {
+	ret := _m.Called(runWorkspaceCommandsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RunWorkspaceCommands")
+	}
+	if rf, ok := ret.Get(0).(func(runWorkspaceCommandsOptions *schematicsv1.RunWorkspaceCommandsOptions) (*schematicsv1.WorkspaceActivityCommandResult, *core.DetailedResponse, error)); ok {
+		return rf(runWorkspaceCommandsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityCommandResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RunWorkspaceCommandsWithContext provides a mock function for SchematicsV1API.RunWorkspaceCommandsWithContext.
+func (_m *SchematicsV1API) RunWorkspaceCommandsWithContext(ctx context.Context, runWorkspaceCommandsOptions *schematicsv1.RunWorkspaceCommandsOptions) (*schematicsv1.WorkspaceActivityCommandResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, runWorkspaceCommandsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RunWorkspaceCommandsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, runWorkspaceCommandsOptions *schematicsv1.RunWorkspaceCommandsOptions) (*schematicsv1.WorkspaceActivityCommandResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, runWorkspaceCommandsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityCommandResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ApplyWorkspaceCommand provides a mock function for SchematicsV1API.ApplyWorkspaceCommand.
+func (_m *SchematicsV1API) ApplyWorkspaceCommand(applyWorkspaceCommandOptions *schematicsv1.ApplyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(applyWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyWorkspaceCommand")
+	}
+	if rf, ok := ret.Get(0).(func(applyWorkspaceCommandOptions *schematicsv1.ApplyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error)); ok {
+		return rf(applyWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityApplyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ApplyWorkspaceCommandWithContext provides a mock function for SchematicsV1API.ApplyWorkspaceCommandWithContext.
+func (_m *SchematicsV1API) ApplyWorkspaceCommandWithContext(ctx context.Context, applyWorkspaceCommandOptions *schematicsv1.ApplyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, applyWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyWorkspaceCommandWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, applyWorkspaceCommandOptions *schematicsv1.ApplyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityApplyResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, applyWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityApplyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DestroyWorkspaceCommand provides a mock function for SchematicsV1API.DestroyWorkspaceCommand.
+func (_m *SchematicsV1API) DestroyWorkspaceCommand(destroyWorkspaceCommandOptions *schematicsv1.DestroyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityDestroyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(destroyWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DestroyWorkspaceCommand")
+	}
+	if rf, ok := ret.Get(0).(func(destroyWorkspaceCommandOptions *schematicsv1.DestroyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityDestroyResult, *core.DetailedResponse, error)); ok {
+		return rf(destroyWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityDestroyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DestroyWorkspaceCommandWithContext provides a mock function for SchematicsV1API.DestroyWorkspaceCommandWithContext.
+func (_m *SchematicsV1API) DestroyWorkspaceCommandWithContext(ctx context.Context, destroyWorkspaceCommandOptions *schematicsv1.DestroyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityDestroyResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, destroyWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DestroyWorkspaceCommandWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, destroyWorkspaceCommandOptions *schematicsv1.DestroyWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityDestroyResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, destroyWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityDestroyResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PlanWorkspaceCommand provides a mock function for SchematicsV1API.PlanWorkspaceCommand.
+func (_m *SchematicsV1API) PlanWorkspaceCommand(planWorkspaceCommandOptions *schematicsv1.PlanWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityPlanResult, *core.DetailedResponse, error) {
+	ret := _m.Called(planWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for PlanWorkspaceCommand")
+	}
+	if rf, ok := ret.Get(0).(func(planWorkspaceCommandOptions *schematicsv1.PlanWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityPlanResult, *core.DetailedResponse, error)); ok {
+		return rf(planWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityPlanResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PlanWorkspaceCommandWithContext provides a mock function for SchematicsV1API.PlanWorkspaceCommandWithContext.
+func (_m *SchematicsV1API) PlanWorkspaceCommandWithContext(ctx context.Context, planWorkspaceCommandOptions *schematicsv1.PlanWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityPlanResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, planWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for PlanWorkspaceCommandWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, planWorkspaceCommandOptions *schematicsv1.PlanWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityPlanResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, planWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityPlanResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RefreshWorkspaceCommand provides a mock function for SchematicsV1API.RefreshWorkspaceCommand.
+func (_m *SchematicsV1API) RefreshWorkspaceCommand(refreshWorkspaceCommandOptions *schematicsv1.RefreshWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityRefreshResult, *core.DetailedResponse, error) {
+	ret := _m.Called(refreshWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshWorkspaceCommand")
+	}
+	if rf, ok := ret.Get(0).(func(refreshWorkspaceCommandOptions *schematicsv1.RefreshWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityRefreshResult, *core.DetailedResponse, error)); ok {
+		return rf(refreshWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityRefreshResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RefreshWorkspaceCommandWithContext provides a mock function for SchematicsV1API.RefreshWorkspaceCommandWithContext.
+func (_m *SchematicsV1API) RefreshWorkspaceCommandWithContext(ctx context.Context, refreshWorkspaceCommandOptions *schematicsv1.RefreshWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityRefreshResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, refreshWorkspaceCommandOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshWorkspaceCommandWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, refreshWorkspaceCommandOptions *schematicsv1.RefreshWorkspaceCommandOptions) (*schematicsv1.WorkspaceActivityRefreshResult, *core.DetailedResponse, error)); ok {
+		return rf(ctx, refreshWorkspaceCommandOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceActivityRefreshResult)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListJobs provides a mock function for SchematicsV1API.ListJobs.
+func (_m *SchematicsV1API) ListJobs(listJobsOptions *schematicsv1.ListJobsOptions) (*schematicsv1.JobList, *core.DetailedResponse, error) {
+	ret := _m.Called(listJobsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+	if rf, ok := ret.Get(0).(func(listJobsOptions *schematicsv1.ListJobsOptions) (*schematicsv1.JobList, *core.DetailedResponse, error)); ok {
+		return rf(listJobsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListJobsWithContext provides a mock function for SchematicsV1API.ListJobsWithContext.
+func (_m *SchematicsV1API) ListJobsWithContext(ctx context.Context, listJobsOptions *schematicsv1.ListJobsOptions) (*schematicsv1.JobList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listJobsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listJobsOptions *schematicsv1.ListJobsOptions) (*schematicsv1.JobList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listJobsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateJob provides a mock function for SchematicsV1API.CreateJob.
+func (_m *SchematicsV1API) CreateJob(createJobOptions *schematicsv1.CreateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(createJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+	if rf, ok := ret.Get(0).(func(createJobOptions *schematicsv1.CreateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(createJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateJobWithContext provides a mock function for SchematicsV1API.CreateJobWithContext.
+func (_m *SchematicsV1API) CreateJobWithContext(ctx context.Context, createJobOptions *schematicsv1.CreateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createJobOptions *schematicsv1.CreateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetJob provides a mock function for SchematicsV1API.GetJob.
+func (_m *SchematicsV1API) GetJob(getJobOptions *schematicsv1.GetJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(getJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+	if rf, ok := ret.Get(0).(func(getJobOptions *schematicsv1.GetJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(getJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetJobWithContext provides a mock function for SchematicsV1API.GetJobWithContext.
+func (_m *SchematicsV1API) GetJobWithContext(ctx context.Context, getJobOptions *schematicsv1.GetJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getJobOptions *schematicsv1.GetJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateJob provides a mock function for SchematicsV1API.UpdateJob.
+func (_m *SchematicsV1API) UpdateJob(updateJobOptions *schematicsv1.UpdateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(updateJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+	if rf, ok := ret.Get(0).(func(updateJobOptions *schematicsv1.UpdateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(updateJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateJobWithContext provides a mock function for SchematicsV1API.UpdateJobWithContext.
+func (_m *SchematicsV1API) UpdateJobWithContext(ctx context.Context, updateJobOptions *schematicsv1.UpdateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateJobOptions *schematicsv1.UpdateJobOptions) (*schematicsv1.Job, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Job)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteJob provides a mock function for SchematicsV1API.DeleteJob.
+func (_m *SchematicsV1API) DeleteJob(deleteJobOptions *schematicsv1.DeleteJobOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJob")
+	}
+	if rf, ok := ret.Get(0).(func(deleteJobOptions *schematicsv1.DeleteJobOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteJobOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteJobWithContext provides a mock function for SchematicsV1API.DeleteJobWithContext.
+func (_m *SchematicsV1API) DeleteJobWithContext(ctx context.Context, deleteJobOptions *schematicsv1.DeleteJobOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteJobOptions *schematicsv1.DeleteJobOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteJobOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListJobLogs provides a mock function for SchematicsV1API.ListJobLogs.
+func (_m *SchematicsV1API) ListJobLogs(listJobLogsOptions *schematicsv1.ListJobLogsOptions) (*schematicsv1.JobLog, *core.DetailedResponse, error) {
+	ret := _m.Called(listJobLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobLogs")
+	}
+	if rf, ok := ret.Get(0).(func(listJobLogsOptions *schematicsv1.ListJobLogsOptions) (*schematicsv1.JobLog, *core.DetailedResponse, error)); ok {
+		return rf(listJobLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobLog)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListJobLogsWithContext provides a mock function for SchematicsV1API.ListJobLogsWithContext.
+func (_m *SchematicsV1API) ListJobLogsWithContext(ctx context.Context, listJobLogsOptions *schematicsv1.ListJobLogsOptions) (*schematicsv1.JobLog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listJobLogsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobLogsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listJobLogsOptions *schematicsv1.ListJobLogsOptions) (*schematicsv1.JobLog, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listJobLogsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobLog)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetJobFiles provides a mock function for SchematicsV1API.GetJobFiles.
+func (_m *SchematicsV1API) GetJobFiles(getJobFilesOptions *schematicsv1.GetJobFilesOptions) (*schematicsv1.JobFileData, *core.DetailedResponse, error) {
+	ret := _m.Called(getJobFilesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobFiles")
+	}
+	if rf, ok := ret.Get(0).(func(getJobFilesOptions *schematicsv1.GetJobFilesOptions) (*schematicsv1.JobFileData, *core.DetailedResponse, error)); ok {
+		return rf(getJobFilesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobFileData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetJobFilesWithContext provides a mock function for SchematicsV1API.GetJobFilesWithContext.
+func (_m *SchematicsV1API) GetJobFilesWithContext(ctx context.Context, getJobFilesOptions *schematicsv1.GetJobFilesOptions) (*schematicsv1.JobFileData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getJobFilesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobFilesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getJobFilesOptions *schematicsv1.GetJobFilesOptions) (*schematicsv1.JobFileData, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getJobFilesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobFileData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateWorkspaceDeletionJob provides a mock function for SchematicsV1API.CreateWorkspaceDeletionJob.
+func (_m *SchematicsV1API) CreateWorkspaceDeletionJob(createWorkspaceDeletionJobOptions *schematicsv1.CreateWorkspaceDeletionJobOptions) (*schematicsv1.WorkspaceBulkDeleteResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createWorkspaceDeletionJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceDeletionJob")
+	}
+	if rf, ok := ret.Get(0).(func(createWorkspaceDeletionJobOptions *schematicsv1.CreateWorkspaceDeletionJobOptions) (*schematicsv1.WorkspaceBulkDeleteResponse, *core.DetailedResponse, error)); ok {
+		return rf(createWorkspaceDeletionJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceBulkDeleteResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateWorkspaceDeletionJobWithContext provides a mock function for SchematicsV1API.CreateWorkspaceDeletionJobWithContext.
+func (_m *SchematicsV1API) CreateWorkspaceDeletionJobWithContext(ctx context.Context, createWorkspaceDeletionJobOptions *schematicsv1.CreateWorkspaceDeletionJobOptions) (*schematicsv1.WorkspaceBulkDeleteResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createWorkspaceDeletionJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspaceDeletionJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createWorkspaceDeletionJobOptions *schematicsv1.CreateWorkspaceDeletionJobOptions) (*schematicsv1.WorkspaceBulkDeleteResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createWorkspaceDeletionJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceBulkDeleteResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceDeletionJobStatus provides a mock function for SchematicsV1API.GetWorkspaceDeletionJobStatus.
+func (_m *SchematicsV1API) GetWorkspaceDeletionJobStatus(getWorkspaceDeletionJobStatusOptions *schematicsv1.GetWorkspaceDeletionJobStatusOptions) (*schematicsv1.WorkspaceJobResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getWorkspaceDeletionJobStatusOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceDeletionJobStatus")
+	}
+	if rf, ok := ret.Get(0).(func(getWorkspaceDeletionJobStatusOptions *schematicsv1.GetWorkspaceDeletionJobStatusOptions) (*schematicsv1.WorkspaceJobResponse, *core.DetailedResponse, error)); ok {
+		return rf(getWorkspaceDeletionJobStatusOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceJobResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetWorkspaceDeletionJobStatusWithContext provides a mock function for SchematicsV1API.GetWorkspaceDeletionJobStatusWithContext.
+func (_m *SchematicsV1API) GetWorkspaceDeletionJobStatusWithContext(ctx context.Context, getWorkspaceDeletionJobStatusOptions *schematicsv1.GetWorkspaceDeletionJobStatusOptions) (*schematicsv1.WorkspaceJobResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getWorkspaceDeletionJobStatusOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceDeletionJobStatusWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getWorkspaceDeletionJobStatusOptions *schematicsv1.GetWorkspaceDeletionJobStatusOptions) (*schematicsv1.WorkspaceJobResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getWorkspaceDeletionJobStatusOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceJobResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListInventories provides a mock function for SchematicsV1API.ListInventories.
+func (_m *SchematicsV1API) ListInventories(listInventoriesOptions *schematicsv1.ListInventoriesOptions) (*schematicsv1.InventoryResourceRecordList, *core.DetailedResponse, error) {
+	ret := _m.Called(listInventoriesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListInventories")
+	}
+	if rf, ok := ret.Get(0).(func(listInventoriesOptions *schematicsv1.ListInventoriesOptions) (*schematicsv1.InventoryResourceRecordList, *core.DetailedResponse, error)); ok {
+		return rf(listInventoriesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecordList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListInventoriesWithContext provides a mock function for SchematicsV1API.ListInventoriesWithContext.
+func (_m *SchematicsV1API) ListInventoriesWithContext(ctx context.Context, listInventoriesOptions *schematicsv1.ListInventoriesOptions) (*schematicsv1.InventoryResourceRecordList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listInventoriesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListInventoriesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listInventoriesOptions *schematicsv1.ListInventoriesOptions) (*schematicsv1.InventoryResourceRecordList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listInventoriesOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecordList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateInventory provides a mock function for SchematicsV1API.CreateInventory.
+func (_m *SchematicsV1API) CreateInventory(createInventoryOptions *schematicsv1.CreateInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(createInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventory")
+	}
+	if rf, ok := ret.Get(0).(func(createInventoryOptions *schematicsv1.CreateInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(createInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateInventoryWithContext provides a mock function for SchematicsV1API.CreateInventoryWithContext.
+func (_m *SchematicsV1API) CreateInventoryWithContext(ctx context.Context, createInventoryOptions *schematicsv1.CreateInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventoryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createInventoryOptions *schematicsv1.CreateInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetInventory provides a mock function for SchematicsV1API.GetInventory.
+func (_m *SchematicsV1API) GetInventory(getInventoryOptions *schematicsv1.GetInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(getInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventory")
+	}
+	if rf, ok := ret.Get(0).(func(getInventoryOptions *schematicsv1.GetInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(getInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetInventoryWithContext provides a mock function for SchematicsV1API.GetInventoryWithContext.
+func (_m *SchematicsV1API) GetInventoryWithContext(ctx context.Context, getInventoryOptions *schematicsv1.GetInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getInventoryOptions *schematicsv1.GetInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceInventory provides a mock function for SchematicsV1API.ReplaceInventory.
+func (_m *SchematicsV1API) ReplaceInventory(replaceInventoryOptions *schematicsv1.ReplaceInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceInventory")
+	}
+	if rf, ok := ret.Get(0).(func(replaceInventoryOptions *schematicsv1.ReplaceInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(replaceInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceInventoryWithContext provides a mock function for SchematicsV1API.ReplaceInventoryWithContext.
+func (_m *SchematicsV1API) ReplaceInventoryWithContext(ctx context.Context, replaceInventoryOptions *schematicsv1.ReplaceInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceInventoryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, replaceInventoryOptions *schematicsv1.ReplaceInventoryOptions) (*schematicsv1.InventoryResourceRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, replaceInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteInventory provides a mock function for SchematicsV1API.DeleteInventory.
+func (_m *SchematicsV1API) DeleteInventory(deleteInventoryOptions *schematicsv1.DeleteInventoryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInventory")
+	}
+	if rf, ok := ret.Get(0).(func(deleteInventoryOptions *schematicsv1.DeleteInventoryOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteInventoryWithContext provides a mock function for SchematicsV1API.DeleteInventoryWithContext.
+func (_m *SchematicsV1API) DeleteInventoryWithContext(ctx context.Context, deleteInventoryOptions *schematicsv1.DeleteInventoryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteInventoryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInventoryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteInventoryOptions *schematicsv1.DeleteInventoryOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteInventoryOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListResourceQuery provides a mock function for SchematicsV1API.ListResourceQuery.
+func (_m *SchematicsV1API) ListResourceQuery(listResourceQueryOptions *schematicsv1.ListResourceQueryOptions) (*schematicsv1.ResourceQueryRecordList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListResourceQuery")
+	}
+	if rf, ok := ret.Get(0).(func(listResourceQueryOptions *schematicsv1.ListResourceQueryOptions) (*schematicsv1.ResourceQueryRecordList, *core.DetailedResponse, error)); ok {
+		return rf(listResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecordList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceQueryWithContext provides a mock function for SchematicsV1API.ListResourceQueryWithContext.
+func (_m *SchematicsV1API) ListResourceQueryWithContext(ctx context.Context, listResourceQueryOptions *schematicsv1.ListResourceQueryOptions) (*schematicsv1.ResourceQueryRecordList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListResourceQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listResourceQueryOptions *schematicsv1.ListResourceQueryOptions) (*schematicsv1.ResourceQueryRecordList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecordList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceQuery provides a mock function for SchematicsV1API.CreateResourceQuery.
+func (_m *SchematicsV1API) CreateResourceQuery(createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(createResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateResourceQuery")
+	}
+	if rf, ok := ret.Get(0).(func(createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(createResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceQueryWithContext provides a mock function for SchematicsV1API.CreateResourceQueryWithContext.
+func (_m *SchematicsV1API) CreateResourceQueryWithContext(ctx context.Context, createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateResourceQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourcesQuery provides a mock function for SchematicsV1API.GetResourcesQuery.
+func (_m *SchematicsV1API) GetResourcesQuery(getResourcesQueryOptions *schematicsv1.GetResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourcesQuery")
+	}
+	if rf, ok := ret.Get(0).(func(getResourcesQueryOptions *schematicsv1.GetResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(getResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourcesQueryWithContext provides a mock function for SchematicsV1API.GetResourcesQueryWithContext.
+func (_m *SchematicsV1API) GetResourcesQueryWithContext(ctx context.Context, getResourcesQueryOptions *schematicsv1.GetResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourcesQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getResourcesQueryOptions *schematicsv1.GetResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceResourcesQuery provides a mock function for SchematicsV1API.ReplaceResourcesQuery.
+func (_m *SchematicsV1API) ReplaceResourcesQuery(replaceResourcesQueryOptions *schematicsv1.ReplaceResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceResourcesQuery")
+	}
+	if rf, ok := ret.Get(0).(func(replaceResourcesQueryOptions *schematicsv1.ReplaceResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(replaceResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceResourcesQueryWithContext provides a mock function for SchematicsV1API.ReplaceResourcesQueryWithContext.
+func (_m *SchematicsV1API) ReplaceResourcesQueryWithContext(ctx context.Context, replaceResourcesQueryOptions *schematicsv1.ReplaceResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceResourcesQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, replaceResourcesQueryOptions *schematicsv1.ReplaceResourcesQueryOptions) (*schematicsv1.ResourceQueryRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, replaceResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ExecuteResourceQuery provides a mock function for SchematicsV1API.ExecuteResourceQuery.
+func (_m *SchematicsV1API) ExecuteResourceQuery(executeResourceQueryOptions *schematicsv1.ExecuteResourceQueryOptions) (*schematicsv1.ResourceQueryResponseRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(executeResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteResourceQuery")
+	}
+	if rf, ok := ret.Get(0).(func(executeResourceQueryOptions *schematicsv1.ExecuteResourceQueryOptions) (*schematicsv1.ResourceQueryResponseRecord, *core.DetailedResponse, error)); ok {
+		return rf(executeResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryResponseRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ExecuteResourceQueryWithContext provides a mock function for SchematicsV1API.ExecuteResourceQueryWithContext.
+func (_m *SchematicsV1API) ExecuteResourceQueryWithContext(ctx context.Context, executeResourceQueryOptions *schematicsv1.ExecuteResourceQueryOptions) (*schematicsv1.ResourceQueryResponseRecord, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, executeResourceQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteResourceQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, executeResourceQueryOptions *schematicsv1.ExecuteResourceQueryOptions) (*schematicsv1.ResourceQueryResponseRecord, *core.DetailedResponse, error)); ok {
+		return rf(ctx, executeResourceQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryResponseRecord)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourcesQuery provides a mock function for SchematicsV1API.DeleteResourcesQuery.
+func (_m *SchematicsV1API) DeleteResourcesQuery(deleteResourcesQueryOptions *schematicsv1.DeleteResourcesQueryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResourcesQuery")
+	}
+	if rf, ok := ret.Get(0).(func(deleteResourcesQueryOptions *schematicsv1.DeleteResourcesQueryOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourcesQueryWithContext provides a mock function for SchematicsV1API.DeleteResourcesQueryWithContext.
+func (_m *SchematicsV1API) DeleteResourcesQueryWithContext(ctx context.Context, deleteResourcesQueryOptions *schematicsv1.DeleteResourcesQueryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourcesQueryOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResourcesQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteResourcesQueryOptions *schematicsv1.DeleteResourcesQueryOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteResourcesQueryOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListAgent provides a mock function for SchematicsV1API.ListAgent.
+func (_m *SchematicsV1API) ListAgent(listAgentOptions *schematicsv1.ListAgentOptions) (*schematicsv1.AgentList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListAgent")
+	}
+	if rf, ok := ret.Get(0).(func(listAgentOptions *schematicsv1.ListAgentOptions) (*schematicsv1.AgentList, *core.DetailedResponse, error)); ok {
+		return rf(listAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAgentWithContext provides a mock function for SchematicsV1API.ListAgentWithContext.
+func (_m *SchematicsV1API) ListAgentWithContext(ctx context.Context, listAgentOptions *schematicsv1.ListAgentOptions) (*schematicsv1.AgentList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListAgentWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listAgentOptions *schematicsv1.ListAgentOptions) (*schematicsv1.AgentList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RegisterAgent provides a mock function for SchematicsV1API.RegisterAgent.
+func (_m *SchematicsV1API) RegisterAgent(registerAgentOptions *schematicsv1.RegisterAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(registerAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterAgent")
+	}
+	if rf, ok := ret.Get(0).(func(registerAgentOptions *schematicsv1.RegisterAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(registerAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RegisterAgentWithContext provides a mock function for SchematicsV1API.RegisterAgentWithContext.
+func (_m *SchematicsV1API) RegisterAgentWithContext(ctx context.Context, registerAgentOptions *schematicsv1.RegisterAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, registerAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterAgentWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, registerAgentOptions *schematicsv1.RegisterAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(ctx, registerAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAgent provides a mock function for SchematicsV1API.GetAgent.
+func (_m *SchematicsV1API) GetAgent(getAgentOptions *schematicsv1.GetAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(getAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgent")
+	}
+	if rf, ok := ret.Get(0).(func(getAgentOptions *schematicsv1.GetAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(getAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAgentWithContext provides a mock function for SchematicsV1API.GetAgentWithContext.
+func (_m *SchematicsV1API) GetAgentWithContext(ctx context.Context, getAgentOptions *schematicsv1.GetAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgentWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getAgentOptions *schematicsv1.GetAgentOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAgent provides a mock function for SchematicsV1API.DeleteAgent.
+func (_m *SchematicsV1API) DeleteAgent(deleteAgentOptions *schematicsv1.DeleteAgentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgent")
+	}
+	if rf, ok := ret.Get(0).(func(deleteAgentOptions *schematicsv1.DeleteAgentOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAgentWithContext provides a mock function for SchematicsV1API.DeleteAgentWithContext.
+func (_m *SchematicsV1API) DeleteAgentWithContext(ctx context.Context, deleteAgentOptions *schematicsv1.DeleteAgentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAgentOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgentWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteAgentOptions *schematicsv1.DeleteAgentOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteAgentOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateAgentRegistration provides a mock function for SchematicsV1API.UpdateAgentRegistration.
+func (_m *SchematicsV1API) UpdateAgentRegistration(updateAgentRegistrationOptions *schematicsv1.UpdateAgentRegistrationOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAgentRegistrationOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentRegistration")
+	}
+	if rf, ok := ret.Get(0).(func(updateAgentRegistrationOptions *schematicsv1.UpdateAgentRegistrationOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(updateAgentRegistrationOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAgentRegistrationWithContext provides a mock function for SchematicsV1API.UpdateAgentRegistrationWithContext.
+func (_m *SchematicsV1API) UpdateAgentRegistrationWithContext(ctx context.Context, updateAgentRegistrationOptions *schematicsv1.UpdateAgentRegistrationOptions) (*schematicsv1.Agent, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAgentRegistrationOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentRegistrationWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateAgentRegistrationOptions *schematicsv1.UpdateAgentRegistrationOptions) (*schematicsv1.Agent, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateAgentRegistrationOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Agent)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAgentData provides a mock function for SchematicsV1API.ListAgentData.
+func (_m *SchematicsV1API) ListAgentData(listAgentDataOptions *schematicsv1.ListAgentDataOptions) (*schematicsv1.AgentDataList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(listAgentDataOptions *schematicsv1.ListAgentDataOptions) (*schematicsv1.AgentDataList, *core.DetailedResponse, error)); ok {
+		return rf(listAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDataList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAgentDataWithContext provides a mock function for SchematicsV1API.ListAgentDataWithContext.
+func (_m *SchematicsV1API) ListAgentDataWithContext(ctx context.Context, listAgentDataOptions *schematicsv1.ListAgentDataOptions) (*schematicsv1.AgentDataList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listAgentDataOptions *schematicsv1.ListAgentDataOptions) (*schematicsv1.AgentDataList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDataList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAgentData provides a mock function for SchematicsV1API.CreateAgentData.
+func (_m *SchematicsV1API) CreateAgentData(createAgentDataOptions *schematicsv1.CreateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(createAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(createAgentDataOptions *schematicsv1.CreateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(createAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAgentDataWithContext provides a mock function for SchematicsV1API.CreateAgentDataWithContext.
+func (_m *SchematicsV1API) CreateAgentDataWithContext(ctx context.Context, createAgentDataOptions *schematicsv1.CreateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createAgentDataOptions *schematicsv1.CreateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAgentData provides a mock function for SchematicsV1API.GetAgentData.
+func (_m *SchematicsV1API) GetAgentData(getAgentDataOptions *schematicsv1.GetAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(getAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(getAgentDataOptions *schematicsv1.GetAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(getAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAgentDataWithContext provides a mock function for SchematicsV1API.GetAgentDataWithContext.
+func (_m *SchematicsV1API) GetAgentDataWithContext(ctx context.Context, getAgentDataOptions *schematicsv1.GetAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getAgentDataOptions *schematicsv1.GetAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAgentData provides a mock function for SchematicsV1API.UpdateAgentData.
+func (_m *SchematicsV1API) UpdateAgentData(updateAgentDataOptions *schematicsv1.UpdateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(updateAgentDataOptions *schematicsv1.UpdateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(updateAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAgentDataWithContext provides a mock function for SchematicsV1API.UpdateAgentDataWithContext.
+func (_m *SchematicsV1API) UpdateAgentDataWithContext(ctx context.Context, updateAgentDataOptions *schematicsv1.UpdateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateAgentDataOptions *schematicsv1.UpdateAgentDataOptions) (*schematicsv1.AgentData, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAgentData provides a mock function for SchematicsV1API.DeleteAgentData.
+func (_m *SchematicsV1API) DeleteAgentData(deleteAgentDataOptions *schematicsv1.DeleteAgentDataOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(deleteAgentDataOptions *schematicsv1.DeleteAgentDataOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAgentDataWithContext provides a mock function for SchematicsV1API.DeleteAgentDataWithContext.
+func (_m *SchematicsV1API) DeleteAgentDataWithContext(ctx context.Context, deleteAgentDataOptions *schematicsv1.DeleteAgentDataOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAgentDataOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteAgentDataOptions *schematicsv1.DeleteAgentDataOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteAgentDataOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetAgentVersions provides a mock function for SchematicsV1API.GetAgentVersions.
+func (_m *SchematicsV1API) GetAgentVersions(getAgentVersionsOptions *schematicsv1.GetAgentVersionsOptions) (*schematicsv1.AgentVersions, *core.DetailedResponse, error) {
+	ret := _m.Called(getAgentVersionsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgentVersions")
+	}
+	if rf, ok := ret.Get(0).(func(getAgentVersionsOptions *schematicsv1.GetAgentVersionsOptions) (*schematicsv1.AgentVersions, *core.DetailedResponse, error)); ok {
+		return rf(getAgentVersionsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentVersions)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAgentVersionsWithContext provides a mock function for SchematicsV1API.GetAgentVersionsWithContext.
+func (_m *SchematicsV1API) GetAgentVersionsWithContext(ctx context.Context, getAgentVersionsOptions *schematicsv1.GetAgentVersionsOptions) (*schematicsv1.AgentVersions, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAgentVersionsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAgentVersionsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getAgentVersionsOptions *schematicsv1.GetAgentVersionsOptions) (*schematicsv1.AgentVersions, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getAgentVersionsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentVersions)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPrsAgentJob provides a mock function for SchematicsV1API.GetPrsAgentJob.
+func (_m *SchematicsV1API) GetPrsAgentJob(getPrsAgentJobOptions *schematicsv1.GetPrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error) {
+	ret := _m.Called(getPrsAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrsAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(getPrsAgentJobOptions *schematicsv1.GetPrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error)); ok {
+		return rf(getPrsAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentPRSJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPrsAgentJobWithContext provides a mock function for SchematicsV1API.GetPrsAgentJobWithContext.
+func (_m *SchematicsV1API) GetPrsAgentJobWithContext(ctx context.Context, getPrsAgentJobOptions *schematicsv1.GetPrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPrsAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrsAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getPrsAgentJobOptions *schematicsv1.GetPrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getPrsAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentPRSJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PrsAgentJob provides a mock function for SchematicsV1API.PrsAgentJob.
+func (_m *SchematicsV1API) PrsAgentJob(prsAgentJobOptions *schematicsv1.PrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error) {
+	ret := _m.Called(prsAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for PrsAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(prsAgentJobOptions *schematicsv1.PrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error)); ok {
+		return rf(prsAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentPRSJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PrsAgentJobWithContext provides a mock function for SchematicsV1API.PrsAgentJobWithContext.
+func (_m *SchematicsV1API) PrsAgentJobWithContext(ctx context.Context, prsAgentJobOptions *schematicsv1.PrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, prsAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for PrsAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, prsAgentJobOptions *schematicsv1.PrsAgentJobOptions) (*schematicsv1.AgentPRSJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, prsAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentPRSJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetHealthCheckAgentJob provides a mock function for SchematicsV1API.GetHealthCheckAgentJob.
+func (_m *SchematicsV1API) GetHealthCheckAgentJob(getHealthCheckAgentJobOptions *schematicsv1.GetHealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error) {
+	ret := _m.Called(getHealthCheckAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetHealthCheckAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(getHealthCheckAgentJobOptions *schematicsv1.GetHealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error)); ok {
+		return rf(getHealthCheckAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentHealthJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetHealthCheckAgentJobWithContext provides a mock function for SchematicsV1API.GetHealthCheckAgentJobWithContext.
+func (_m *SchematicsV1API) GetHealthCheckAgentJobWithContext(ctx context.Context, getHealthCheckAgentJobOptions *schematicsv1.GetHealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getHealthCheckAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetHealthCheckAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getHealthCheckAgentJobOptions *schematicsv1.GetHealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getHealthCheckAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentHealthJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// HealthCheckAgentJob provides a mock function for SchematicsV1API.HealthCheckAgentJob.
+func (_m *SchematicsV1API) HealthCheckAgentJob(healthCheckAgentJobOptions *schematicsv1.HealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error) {
+	ret := _m.Called(healthCheckAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for HealthCheckAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(healthCheckAgentJobOptions *schematicsv1.HealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error)); ok {
+		return rf(healthCheckAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentHealthJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// HealthCheckAgentJobWithContext provides a mock function for SchematicsV1API.HealthCheckAgentJobWithContext.
+func (_m *SchematicsV1API) HealthCheckAgentJobWithContext(ctx context.Context, healthCheckAgentJobOptions *schematicsv1.HealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, healthCheckAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for HealthCheckAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, healthCheckAgentJobOptions *schematicsv1.HealthCheckAgentJobOptions) (*schematicsv1.AgentHealthJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, healthCheckAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentHealthJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetDeployAgentJob provides a mock function for SchematicsV1API.GetDeployAgentJob.
+func (_m *SchematicsV1API) GetDeployAgentJob(getDeployAgentJobOptions *schematicsv1.GetDeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error) {
+	ret := _m.Called(getDeployAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeployAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(getDeployAgentJobOptions *schematicsv1.GetDeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error)); ok {
+		return rf(getDeployAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDeployJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetDeployAgentJobWithContext provides a mock function for SchematicsV1API.GetDeployAgentJobWithContext.
+func (_m *SchematicsV1API) GetDeployAgentJobWithContext(ctx context.Context, getDeployAgentJobOptions *schematicsv1.GetDeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getDeployAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeployAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getDeployAgentJobOptions *schematicsv1.GetDeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getDeployAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDeployJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeployAgentJob provides a mock function for SchematicsV1API.DeployAgentJob.
+func (_m *SchematicsV1API) DeployAgentJob(deployAgentJobOptions *schematicsv1.DeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error) {
+	ret := _m.Called(deployAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeployAgentJob")
+	}
+	if rf, ok := ret.Get(0).(func(deployAgentJobOptions *schematicsv1.DeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error)); ok {
+		return rf(deployAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDeployJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeployAgentJobWithContext provides a mock function for SchematicsV1API.DeployAgentJobWithContext.
+func (_m *SchematicsV1API) DeployAgentJobWithContext(ctx context.Context, deployAgentJobOptions *schematicsv1.DeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deployAgentJobOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeployAgentJobWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deployAgentJobOptions *schematicsv1.DeployAgentJobOptions) (*schematicsv1.AgentDeployJob, *core.DetailedResponse, error)); ok {
+		return rf(ctx, deployAgentJobOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentDeployJob)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAgentResources provides a mock function for SchematicsV1API.DeleteAgentResources.
+func (_m *SchematicsV1API) DeleteAgentResources(deleteAgentResourcesOptions *schematicsv1.DeleteAgentResourcesOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAgentResourcesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgentResources")
+	}
+	if rf, ok := ret.Get(0).(func(deleteAgentResourcesOptions *schematicsv1.DeleteAgentResourcesOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deleteAgentResourcesOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAgentResourcesWithContext provides a mock function for SchematicsV1API.DeleteAgentResourcesWithContext.
+func (_m *SchematicsV1API) DeleteAgentResourcesWithContext(ctx context.Context, deleteAgentResourcesOptions *schematicsv1.DeleteAgentResourcesOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAgentResourcesOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAgentResourcesWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deleteAgentResourcesOptions *schematicsv1.DeleteAgentResourcesOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deleteAgentResourcesOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetKmsSettings provides a mock function for SchematicsV1API.GetKmsSettings.
+func (_m *SchematicsV1API) GetKmsSettings(getKmsSettingsOptions *schematicsv1.GetKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getKmsSettingsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetKmsSettings")
+	}
+	if rf, ok := ret.Get(0).(func(getKmsSettingsOptions *schematicsv1.GetKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error)); ok {
+		return rf(getKmsSettingsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSSettings)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetKmsSettingsWithContext provides a mock function for SchematicsV1API.GetKmsSettingsWithContext.
+func (_m *SchematicsV1API) GetKmsSettingsWithContext(ctx context.Context, getKmsSettingsOptions *schematicsv1.GetKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getKmsSettingsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetKmsSettingsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getKmsSettingsOptions *schematicsv1.GetKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getKmsSettingsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSSettings)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateKmsSettings provides a mock function for SchematicsV1API.UpdateKmsSettings.
+func (_m *SchematicsV1API) UpdateKmsSettings(updateKmsSettingsOptions *schematicsv1.UpdateKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(updateKmsSettingsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKmsSettings")
+	}
+	if rf, ok := ret.Get(0).(func(updateKmsSettingsOptions *schematicsv1.UpdateKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error)); ok {
+		return rf(updateKmsSettingsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSSettings)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateKmsSettingsWithContext provides a mock function for SchematicsV1API.UpdateKmsSettingsWithContext.
+func (_m *SchematicsV1API) UpdateKmsSettingsWithContext(ctx context.Context, updateKmsSettingsOptions *schematicsv1.UpdateKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateKmsSettingsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKmsSettingsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updateKmsSettingsOptions *schematicsv1.UpdateKmsSettingsOptions) (*schematicsv1.KMSSettings, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateKmsSettingsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSSettings)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListKms provides a mock function for SchematicsV1API.ListKms.
+func (_m *SchematicsV1API) ListKms(listKmsOptions *schematicsv1.ListKmsOptions) (*schematicsv1.KMSDiscovery, *core.DetailedResponse, error) {
+	ret := _m.Called(listKmsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListKms")
+	}
+	if rf, ok := ret.Get(0).(func(listKmsOptions *schematicsv1.ListKmsOptions) (*schematicsv1.KMSDiscovery, *core.DetailedResponse, error)); ok {
+		return rf(listKmsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSDiscovery)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListKmsWithContext provides a mock function for SchematicsV1API.ListKmsWithContext.
+func (_m *SchematicsV1API) ListKmsWithContext(ctx context.Context, listKmsOptions *schematicsv1.ListKmsOptions) (*schematicsv1.KMSDiscovery, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listKmsOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListKmsWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listKmsOptions *schematicsv1.ListKmsOptions) (*schematicsv1.KMSDiscovery, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listKmsOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.KMSDiscovery)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicy provides a mock function for SchematicsV1API.ListPolicy.
+func (_m *SchematicsV1API) ListPolicy(listPolicyOptions *schematicsv1.ListPolicyOptions) (*schematicsv1.PolicyList, *core.DetailedResponse, error) {
+	ret := _m.Called(listPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicy")
+	}
+	if rf, ok := ret.Get(0).(func(listPolicyOptions *schematicsv1.ListPolicyOptions) (*schematicsv1.PolicyList, *core.DetailedResponse, error)); ok {
+		return rf(listPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.PolicyList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicyWithContext provides a mock function for SchematicsV1API.ListPolicyWithContext.
+func (_m *SchematicsV1API) ListPolicyWithContext(ctx context.Context, listPolicyOptions *schematicsv1.ListPolicyOptions) (*schematicsv1.PolicyList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, listPolicyOptions *schematicsv1.ListPolicyOptions) (*schematicsv1.PolicyList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.PolicyList)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicy provides a mock function for SchematicsV1API.CreatePolicy.
+func (_m *SchematicsV1API) CreatePolicy(createPolicyOptions *schematicsv1.CreatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(createPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicy")
+	}
+	if rf, ok := ret.Get(0).(func(createPolicyOptions *schematicsv1.CreatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(createPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyWithContext provides a mock function for SchematicsV1API.CreatePolicyWithContext.
+func (_m *SchematicsV1API) CreatePolicyWithContext(ctx context.Context, createPolicyOptions *schematicsv1.CreatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createPolicyOptions *schematicsv1.CreatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(ctx, createPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicy provides a mock function for SchematicsV1API.GetPolicy.
+func (_m *SchematicsV1API) GetPolicy(getPolicyOptions *schematicsv1.GetPolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(getPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicy")
+	}
+	if rf, ok := ret.Get(0).(func(getPolicyOptions *schematicsv1.GetPolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(getPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyWithContext provides a mock function for SchematicsV1API.GetPolicyWithContext.
+func (_m *SchematicsV1API) GetPolicyWithContext(ctx context.Context, getPolicyOptions *schematicsv1.GetPolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, getPolicyOptions *schematicsv1.GetPolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getPolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePolicy provides a mock function for SchematicsV1API.DeletePolicy.
+func (_m *SchematicsV1API) DeletePolicy(deletePolicyOptions *schematicsv1.DeletePolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+	if rf, ok := ret.Get(0).(func(deletePolicyOptions *schematicsv1.DeletePolicyOptions) (*core.DetailedResponse, error)); ok {
+		return rf(deletePolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePolicyWithContext provides a mock function for SchematicsV1API.DeletePolicyWithContext.
+func (_m *SchematicsV1API) DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *schematicsv1.DeletePolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, deletePolicyOptions *schematicsv1.DeletePolicyOptions) (*core.DetailedResponse, error)); ok {
+		return rf(ctx, deletePolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*core.DetailedResponse)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdatePolicy provides a mock function for SchematicsV1API.UpdatePolicy.
+func (_m *SchematicsV1API) UpdatePolicy(updatePolicyOptions *schematicsv1.UpdatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(updatePolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicy")
+	}
+	if rf, ok := ret.Get(0).(func(updatePolicyOptions *schematicsv1.UpdatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(updatePolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePolicyWithContext provides a mock function for SchematicsV1API.UpdatePolicyWithContext.
+func (_m *SchematicsV1API) UpdatePolicyWithContext(ctx context.Context, updatePolicyOptions *schematicsv1.UpdatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updatePolicyOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, updatePolicyOptions *schematicsv1.UpdatePolicyOptions) (*schematicsv1.Policy, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updatePolicyOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ResolveInventory provides a mock function for SchematicsV1API.ResolveInventory.
+func (_m *SchematicsV1API) ResolveInventory(ctx context.Context, inventoryID string) (*schematicsv1.DynamicInventory, error) {
+	ret := _m.Called(ctx, inventoryID)
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveInventory")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, inventoryID string) (*schematicsv1.DynamicInventory, error)); ok {
+		return rf(ctx, inventoryID)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.DynamicInventory)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ResolveInventoryRecord provides a mock function for SchematicsV1API.ResolveInventoryRecord.
+func (_m *SchematicsV1API) ResolveInventoryRecord(ctx context.Context, record *schematicsv1.InventoryResourceRecord) (*schematicsv1.DynamicInventory, error) {
+	ret := _m.Called(ctx, record)
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveInventoryRecord")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, record *schematicsv1.InventoryResourceRecord) (*schematicsv1.DynamicInventory, error)); ok {
+		return rf(ctx, record)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.DynamicInventory)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// EnsureWorkspace provides a mock function for SchematicsV1API.EnsureWorkspace.
+func (_m *SchematicsV1API) EnsureWorkspace(createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.WorkspaceResponse, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createWorkspaceOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.WorkspaceResponse, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createWorkspaceOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureWorkspaceWithContext provides a mock function for SchematicsV1API.EnsureWorkspaceWithContext.
+func (_m *SchematicsV1API) EnsureWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.WorkspaceResponse, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createWorkspaceOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureWorkspaceWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createWorkspaceOptions *schematicsv1.CreateWorkspaceOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.WorkspaceResponse, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createWorkspaceOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceResponse)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureAction provides a mock function for SchematicsV1API.EnsureAction.
+func (_m *SchematicsV1API) EnsureAction(createActionOptions *schematicsv1.CreateActionOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Action, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createActionOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureAction")
+	}
+	if rf, ok := ret.Get(0).(func(createActionOptions *schematicsv1.CreateActionOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Action, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createActionOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureActionWithContext provides a mock function for SchematicsV1API.EnsureActionWithContext.
+func (_m *SchematicsV1API) EnsureActionWithContext(ctx context.Context, createActionOptions *schematicsv1.CreateActionOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Action, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createActionOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureActionWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createActionOptions *schematicsv1.CreateActionOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Action, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createActionOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Action)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureInventory provides a mock function for SchematicsV1API.EnsureInventory.
+func (_m *SchematicsV1API) EnsureInventory(createInventoryOptions *schematicsv1.CreateInventoryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.InventoryResourceRecord, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createInventoryOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureInventory")
+	}
+	if rf, ok := ret.Get(0).(func(createInventoryOptions *schematicsv1.CreateInventoryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.InventoryResourceRecord, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createInventoryOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureInventoryWithContext provides a mock function for SchematicsV1API.EnsureInventoryWithContext.
+func (_m *SchematicsV1API) EnsureInventoryWithContext(ctx context.Context, createInventoryOptions *schematicsv1.CreateInventoryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.InventoryResourceRecord, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createInventoryOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureInventoryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createInventoryOptions *schematicsv1.CreateInventoryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.InventoryResourceRecord, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createInventoryOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.InventoryResourceRecord)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureResourceQuery provides a mock function for SchematicsV1API.EnsureResourceQuery.
+func (_m *SchematicsV1API) EnsureResourceQuery(createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.ResourceQueryRecord, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createResourceQueryOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureResourceQuery")
+	}
+	if rf, ok := ret.Get(0).(func(createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.ResourceQueryRecord, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createResourceQueryOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureResourceQueryWithContext provides a mock function for SchematicsV1API.EnsureResourceQueryWithContext.
+func (_m *SchematicsV1API) EnsureResourceQueryWithContext(ctx context.Context, createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.ResourceQueryRecord, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createResourceQueryOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureResourceQueryWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createResourceQueryOptions *schematicsv1.CreateResourceQueryOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.ResourceQueryRecord, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createResourceQueryOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.ResourceQueryRecord)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureAgentData provides a mock function for SchematicsV1API.EnsureAgentData.
+func (_m *SchematicsV1API) EnsureAgentData(createAgentDataOptions *schematicsv1.CreateAgentDataOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.AgentData, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createAgentDataOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureAgentData")
+	}
+	if rf, ok := ret.Get(0).(func(createAgentDataOptions *schematicsv1.CreateAgentDataOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.AgentData, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createAgentDataOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsureAgentDataWithContext provides a mock function for SchematicsV1API.EnsureAgentDataWithContext.
+func (_m *SchematicsV1API) EnsureAgentDataWithContext(ctx context.Context, createAgentDataOptions *schematicsv1.CreateAgentDataOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.AgentData, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createAgentDataOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureAgentDataWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createAgentDataOptions *schematicsv1.CreateAgentDataOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.AgentData, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createAgentDataOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.AgentData)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsurePolicy provides a mock function for SchematicsV1API.EnsurePolicy.
+func (_m *SchematicsV1API) EnsurePolicy(createPolicyOptions *schematicsv1.CreatePolicyOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Policy, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(createPolicyOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsurePolicy")
+	}
+	if rf, ok := ret.Get(0).(func(createPolicyOptions *schematicsv1.CreatePolicyOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Policy, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(createPolicyOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// EnsurePolicyWithContext provides a mock function for SchematicsV1API.EnsurePolicyWithContext.
+func (_m *SchematicsV1API) EnsurePolicyWithContext(ctx context.Context, createPolicyOptions *schematicsv1.CreatePolicyOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Policy, schematicsv1.EnsureOutcome, error) {
+	ret := _m.Called(ctx, createPolicyOptions, ensureOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for EnsurePolicyWithContext")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, createPolicyOptions *schematicsv1.CreatePolicyOptions, ensureOptions *schematicsv1.EnsureOptions) (*schematicsv1.Policy, schematicsv1.EnsureOutcome, error)); ok {
+		return rf(ctx, createPolicyOptions, ensureOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.Policy)
+	r1, _ := ret.Get(1).(schematicsv1.EnsureOutcome)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DownloadJobArtifacts provides a mock function for SchematicsV1API.DownloadJobArtifacts.
+func (_m *SchematicsV1API) DownloadJobArtifacts(ctx context.Context, jobID string, dir string, options *schematicsv1.DownloadJobArtifactsOptions) (*schematicsv1.JobArtifactsManifest, error) {
+	ret := _m.Called(ctx, jobID, dir, options)
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadJobArtifacts")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, jobID string, dir string, options *schematicsv1.DownloadJobArtifactsOptions) (*schematicsv1.JobArtifactsManifest, error)); ok {
+		return rf(ctx, jobID, dir, options)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.JobArtifactsManifest)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ValidateLocation provides a mock function for SchematicsV1API.ValidateLocation.
+func (_m *SchematicsV1API) ValidateLocation(location string) error {
+	ret := _m.Called(location)
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateLocation")
+	}
+	if rf, ok := ret.Get(0).(func(location string) error); ok {
+		return rf(location)
+	}
+	r0 := ret.Error(0)
+	return r0
+}
+
+// ValidateWorkspaceLocation provides a mock function for SchematicsV1API.ValidateWorkspaceLocation.
+func (_m *SchematicsV1API) ValidateWorkspaceLocation(workspace *schematicsv1.WorkspaceResponse) error {
+	ret := _m.Called(workspace)
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateWorkspaceLocation")
+	}
+	if rf, ok := ret.Get(0).(func(workspace *schematicsv1.WorkspaceResponse) error); ok {
+		return rf(workspace)
+	}
+	r0 := ret.Error(0)
+	return r0
+}
+
+// Workspaces provides a mock function for SchematicsV1API.Workspaces.
+func (_m *SchematicsV1API) Workspaces(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.WorkspaceResponse] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for Workspaces")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.WorkspaceResponse]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.WorkspaceResponse])
+	return r0
+}
+
+// Actions provides a mock function for SchematicsV1API.Actions.
+func (_m *SchematicsV1API) Actions(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.Action] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for Actions")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.Action]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.Action])
+	return r0
+}
+
+// Inventories provides a mock function for SchematicsV1API.Inventories.
+func (_m *SchematicsV1API) Inventories(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.InventoryResourceRecord] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for Inventories")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.InventoryResourceRecord]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.InventoryResourceRecord])
+	return r0
+}
+
+// ResourceQueries provides a mock function for SchematicsV1API.ResourceQueries.
+func (_m *SchematicsV1API) ResourceQueries(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.ResourceQueryRecord] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for ResourceQueries")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.ResourceQueryRecord]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.ResourceQueryRecord])
+	return r0
+}
+
+// Agents provides a mock function for SchematicsV1API.Agents.
+func (_m *SchematicsV1API) Agents(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.AgentData] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for Agents")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.AgentData]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.AgentData])
+	return r0
+}
+
+// Policies provides a mock function for SchematicsV1API.Policies.
+func (_m *SchematicsV1API) Policies(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.Policy] {
+	ret := _m.Called(options)
+	if len(ret) == 0 {
+		panic("no return value specified for Policies")
+	}
+	if rf, ok := ret.Get(0).(func(options *schematicsv1.ResourceOptions) schematicsv1.Resource[schematicsv1.Policy]); ok {
+		return rf(options)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.Resource[schematicsv1.Policy])
+	return r0
+}
+
+// ExecuteResourceQueryHosts provides a mock function for SchematicsV1API.ExecuteResourceQueryHosts.
+func (_m *SchematicsV1API) ExecuteResourceQueryHosts(ctx context.Context, queryID string) (schematicsv1.ResourceQueryHosts, error) {
+	ret := _m.Called(ctx, queryID)
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteResourceQueryHosts")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, queryID string) (schematicsv1.ResourceQueryHosts, error)); ok {
+		return rf(ctx, queryID)
+	}
+	r0, _ := ret.Get(0).(schematicsv1.ResourceQueryHosts)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RunAction provides a mock function for SchematicsV1API.RunAction.
+func (_m *SchematicsV1API) RunAction(ctx context.Context, actionID string, playbook string, runActionOptions *schematicsv1.RunActionOptions) (*schematicsv1.RunActionResult, error) {
+	ret := _m.Called(ctx, actionID, playbook, runActionOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for RunAction")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, actionID string, playbook string, runActionOptions *schematicsv1.RunActionOptions) (*schematicsv1.RunActionResult, error)); ok {
+		return rf(ctx, actionID, playbook, runActionOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.RunActionResult)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UploadWorkspaceTemplateFromDir provides a mock function for SchematicsV1API.UploadWorkspaceTemplateFromDir.
+func (_m *SchematicsV1API) UploadWorkspaceTemplateFromDir(ctx context.Context, wID string, tID string, dir string) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, wID, tID, dir)
+	if len(ret) == 0 {
+		panic("no return value specified for UploadWorkspaceTemplateFromDir")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, wID string, tID string, dir string) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, wID, tID, dir)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadActionTemplateFromDir provides a mock function for SchematicsV1API.UploadActionTemplateFromDir.
+func (_m *SchematicsV1API) UploadActionTemplateFromDir(ctx context.Context, actionID string, dir string) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, actionID, dir)
+	if len(ret) == 0 {
+		panic("no return value specified for UploadActionTemplateFromDir")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, actionID string, dir string) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, actionID, dir)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TemplateRepoUploadWithProgress provides a mock function for SchematicsV1API.TemplateRepoUploadWithProgress.
+func (_m *SchematicsV1API) TemplateRepoUploadWithProgress(ctx context.Context, templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions, uploadOptions *schematicsv1.UploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, templateRepoUploadOptions, uploadOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateRepoUploadWithProgress")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, templateRepoUploadOptions *schematicsv1.TemplateRepoUploadOptions, uploadOptions *schematicsv1.UploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, templateRepoUploadOptions, uploadOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadTemplateTarActionWithProgress provides a mock function for SchematicsV1API.UploadTemplateTarActionWithProgress.
+func (_m *SchematicsV1API) UploadTemplateTarActionWithProgress(ctx context.Context, uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions, uploadOptions *schematicsv1.UploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, uploadTemplateTarActionOptions, uploadOptions)
+	if len(ret) == 0 {
+		panic("no return value specified for UploadTemplateTarActionWithProgress")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, uploadTemplateTarActionOptions *schematicsv1.UploadTemplateTarActionOptions, uploadOptions *schematicsv1.UploadOptions) (*schematicsv1.TemplateRepoTarUploadResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, uploadTemplateTarActionOptions, uploadOptions)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.TemplateRepoTarUploadResponse)
+	r1, _ := ret.Get(1).(*core.DetailedResponse)
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ExportWorkspace provides a mock function for SchematicsV1API.ExportWorkspace.
+func (_m *SchematicsV1API) ExportWorkspace(ctx context.Context, wID string, writer io.Writer, options *schematicsv1.WorkspaceExportOptions) (*schematicsv1.WorkspaceArchiveManifest, error) {
+	ret := _m.Called(ctx, wID, writer, options)
+	if len(ret) == 0 {
+		panic("no return value specified for ExportWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, wID string, writer io.Writer, options *schematicsv1.WorkspaceExportOptions) (*schematicsv1.WorkspaceArchiveManifest, error)); ok {
+		return rf(ctx, wID, writer, options)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceArchiveManifest)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ImportWorkspace provides a mock function for SchematicsV1API.ImportWorkspace.
+func (_m *SchematicsV1API) ImportWorkspace(ctx context.Context, reader io.Reader, options *schematicsv1.WorkspaceImportOptions) (*schematicsv1.WorkspaceImportResult, error) {
+	ret := _m.Called(ctx, reader, options)
+	if len(ret) == 0 {
+		panic("no return value specified for ImportWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, reader io.Reader, options *schematicsv1.WorkspaceImportOptions) (*schematicsv1.WorkspaceImportResult, error)); ok {
+		return rf(ctx, reader, options)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceImportResult)
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CloneWorkspace provides a mock function for SchematicsV1API.CloneWorkspace.
+func (_m *SchematicsV1API) CloneWorkspace(ctx context.Context, srcID string, options *schematicsv1.WorkspaceCloneOptions) (*schematicsv1.WorkspaceCloneResult, error) {
+	ret := _m.Called(ctx, srcID, options)
+	if len(ret) == 0 {
+		panic("no return value specified for CloneWorkspace")
+	}
+	if rf, ok := ret.Get(0).(func(ctx context.Context, srcID string, options *schematicsv1.WorkspaceCloneOptions) (*schematicsv1.WorkspaceCloneResult, error)); ok {
+		return rf(ctx, srcID, options)
+	}
+	r0, _ := ret.Get(0).(*schematicsv1.WorkspaceCloneResult)
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -0,0 +1,76 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// workspaceName uses the service only through the exported interface.
+func workspaceName(service schematicsv1.SchematicsV1API, wID string) (string, error) {
+	result, _, err := service.GetWorkspaceWithContext(context.Background(), &schematicsv1.GetWorkspaceOptions{WID: core.StringPtr(wID)})
+	if err != nil {
+		return "", err
+	}
+	return *result.Name, nil
+}
+
+func TestScriptedReturnValues(t *testing.T) {
+	service := NewSchematicsV1API(t)
+	service.On("GetWorkspaceWithContext", mock.Anything, &schematicsv1.GetWorkspaceOptions{WID: core.StringPtr("ws1")}).
+		Return(&schematicsv1.WorkspaceResponse{Name: core.StringPtr("app")}, &core.DetailedResponse{StatusCode: 200}, nil).
+		Once()
+	service.On("GetWorkspaceWithContext", mock.Anything, mock.Anything).
+		Return(nil, nil, errors.New("not found"))
+
+	name, err := workspaceName(service, "ws1")
+	assert.Nil(t, err)
+	assert.Equal(t, "app", name)
+
+	_, err = workspaceName(service, "ws2")
+	assert.EqualError(t, err, "not found")
+	service.AssertNumberOfCalls(t, "GetWorkspaceWithContext", 2)
+}
+
+func TestReturnFunction(t *testing.T) {
+	service := NewSchematicsV1API(t)
+	service.On("GetWorkspaceWithContext", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, options *schematicsv1.GetWorkspaceOptions) (*schematicsv1.WorkspaceResponse, *core.DetailedResponse, error) {
+			return &schematicsv1.WorkspaceResponse{Name: options.WID}, nil, nil
+		})
+
+	name, err := workspaceName(service, "ws3")
+	assert.Nil(t, err)
+	assert.Equal(t, "ws3", name)
+}
+
+func TestHighLevelHelpers(t *testing.T) {
+	service := NewSchematicsV1API(t)
+	service.On("CloneWorkspace", mock.Anything, "ws1", mock.Anything).
+		Return(&schematicsv1.WorkspaceCloneResult{UncopiedSecureValues: []string{"t1/api_key"}}, nil)
+
+	result, err := service.CloneWorkspace(context.Background(), "ws1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"t1/api_key"}, result.UncopiedSecureValues)
+}
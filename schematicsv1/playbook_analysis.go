@@ -0,0 +1,486 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rules checked by playbook analysis, in addition to TemplateRuleFolderMissing and TemplateRuleSyntax.
+const (
+	PlaybookRuleNoPlaybooks    = "no-playbooks"
+	PlaybookRuleInvalidPlay    = "invalid-play"
+	PlaybookRuleMissingImport  = "missing-import"
+	PlaybookRuleUnmatchedHosts = "unmatched-hosts"
+)
+
+var (
+	// yamlErrorLinePattern matches the line of a YAML syntax error message.
+	yamlErrorLinePattern = regexp.MustCompile(`line (\d+)`)
+
+	// jinjaReferencePattern matches a Jinja expression, capturing the variable it starts with and the rest of it.
+	jinjaReferencePattern = regexp.MustCompile(`\{\{-?\s*([A-Za-z_][A-Za-z0-9_]*)([^}]*)\}\}`)
+
+	// jinjaDefaultFilterPattern matches the default filter, which makes a variable optional.
+	jinjaDefaultFilterPattern = regexp.MustCompile(`\|\s*(?:default|d)\b`)
+
+	// playbookGroupSubscriptPattern matches the subscript of a host pattern such as "web[0:2]".
+	playbookGroupSubscriptPattern = regexp.MustCompile(`\[-?\d*(?::-?\d*)?\]$`)
+)
+
+// playbookImplicitVariables are variables that Ansible defines, in addition to facts and the variables starting
+// with "ansible_".
+var playbookImplicitVariables = []string{
+	"environment", "group_names", "groups", "hostvars", "inventory_dir", "inventory_file", "inventory_hostname",
+	"inventory_hostname_short", "item", "omit", "play_hosts", "playbook_dir", "role_name", "role_path",
+	"true", "false", "none", "True", "False", "None", "not", "range", "lookup", "query", "q",
+}
+
+// playbookTaskLists are the keys of a play whose values are lists of tasks.
+var playbookTaskLists = []string{"pre_tasks", "tasks", "post_tasks", "handlers"}
+
+// playbookConditionKeys are the keys of plays and tasks whose values are raw Jinja expressions rather than
+// templated strings.
+var playbookConditionKeys = []string{"when", "failed_when", "changed_when", "until", "that"}
+
+// PlaybookAnalysisOptions : the options of playbook analysis.
+type PlaybookAnalysisOptions struct {
+	// The folder of the playbooks, relative to the root of the source ("" for the root).
+	Folder string
+
+	// The inventory of the action, if any (see ParseInventory). The host patterns of plays are checked against its
+	// groups and hosts, and its variables count as defined.
+	Inventory *Inventory
+}
+
+// PlaybookReport : the result of analyzing the playbooks of an action source before it is used.
+type PlaybookReport struct {
+	// The analyzed folder, relative to the root of the source ("" for the root).
+	Folder string `json:"folder"`
+
+	// The playbooks of the folder, in lexical order.
+	Playbooks []Playbook `json:"playbooks"`
+
+	// The problems found, in the order they were found.
+	Issues []TemplateValidationIssue `json:"issues,omitempty"`
+}
+
+// Playbook : a playbook found by playbook analysis.
+type Playbook struct {
+	// The file of the playbook, relative to the root of the source. Its base name is the playbook name of the
+	// action (Action.PlaybookName).
+	File string `json:"file"`
+
+	// The host patterns of its plays, in order.
+	Hosts []string `json:"hosts,omitempty"`
+
+	// The variables that the playbook uses without defining them or giving them a default, in lexical order.
+	// They must be set by the inputs of the action, the inventory or group_vars.
+	RequiredVars []string `json:"required_vars,omitempty"`
+
+	// The playbooks it imports with import_playbook.
+	Imports []string `json:"imports,omitempty"`
+}
+
+// Valid reports whether the report has no errors.
+func (report *PlaybookReport) Valid() bool {
+	return len(report.Errors()) == 0
+}
+
+// Errors returns the issues with error severity.
+func (report *PlaybookReport) Errors() (issues []TemplateValidationIssue) {
+	for _, issue := range report.Issues {
+		if issue.Severity == TemplateIssueSeverityError {
+			issues = append(issues, issue)
+		}
+	}
+	return
+}
+
+func (report *PlaybookReport) addIssue(severity string, rule string, file string, line int, format string, args ...interface{}) {
+	report.Issues = append(report.Issues, TemplateValidationIssue{
+		Severity: severity,
+		Rule:     rule,
+		File:     file,
+		Line:     line,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// AnalyzePlaybookDir analyzes the playbooks of a local directory, such as a git checkout, as a TemplateArchive of
+// it would be uploaded. See AnalyzePlaybookArchive.
+func AnalyzePlaybookDir(dir string, options *PlaybookAnalysisOptions) (*PlaybookReport, error) {
+	files, err := readTemplateDir(dir, isYAMLFile)
+	if err != nil {
+		return nil, err
+	}
+	return analyzePlaybooks(files, options), nil
+}
+
+// AnalyzePlaybookArchive analyzes the playbooks of a tar or gzipped tar archive of an action source before
+// CreateAction or UploadTemplateTarAction, without a request to the service.
+//
+// The playbooks are the YAML files directly within the folder (options.Folder) that are lists of plays. For each
+// playbook, the report lists the host patterns of its plays and the variables it uses in Jinja expressions
+// ("{{ name }}") without defining them (in vars, vars_prompt, register, set_fact or loop_control.loop_var) or
+// giving them a default. Variables of the group_vars and host_vars of the folder and of options.Inventory count as
+// defined. This is a heuristic: the variables of roles and of conditions such as "when" are not checked.
+//
+// An error is reported for YAML files that cannot be parsed, for plays without hosts or with task lists, roles or
+// vars of the wrong type, for imported playbooks that do not exist, and, if options.Inventory is set, for each
+// host pattern that matches no group or host of the inventory.
+func AnalyzePlaybookArchive(reader io.Reader, options *PlaybookAnalysisOptions) (*PlaybookReport, error) {
+	files, err := readTemplateTar(reader, isYAMLFile)
+	if err != nil {
+		return nil, err
+	}
+	return analyzePlaybooks(files, options), nil
+}
+
+func analyzePlaybooks(files templateFiles, options *PlaybookAnalysisOptions) *PlaybookReport {
+	if options == nil {
+		options = &PlaybookAnalysisOptions{}
+	}
+	report := &PlaybookReport{Folder: strings.Trim(path.Clean("/"+options.Folder), "/"), Playbooks: []Playbook{}}
+	if report.Folder != "" && !files.hasFolder(report.Folder) {
+		report.addIssue(TemplateIssueSeverityError, TemplateRuleFolderMissing, "", 0, "the source has no folder '%s'", report.Folder)
+		return report
+	}
+
+	shared := map[string]bool{}
+	for _, name := range sortedKeys(files) {
+		if !isYAMLFile(name) || !(inFolderTree(name, path.Join(report.Folder, "group_vars")) || inFolderTree(name, path.Join(report.Folder, "host_vars"))) {
+			continue
+		}
+		if root, ok := parsePlaybookFile(report, name, files[name]); ok && root.Kind == yaml.MappingNode {
+			for i := 0; i < len(root.Content); i += 2 {
+				shared[root.Content[i].Value] = true
+			}
+		}
+	}
+	if options.Inventory != nil {
+		addVars := func(vars []InventoryVar) {
+			for _, variable := range vars {
+				shared[variable.Name] = true
+			}
+		}
+		for _, host := range options.Inventory.Hosts {
+			addVars(host.Vars)
+		}
+		for _, group := range options.Inventory.Groups {
+			addVars(group.Vars)
+			for _, host := range group.Hosts {
+				addVars(host.Vars)
+			}
+		}
+	}
+
+	for _, name := range files.inFolder(report.Folder, ".yml", ".yaml") {
+		root, ok := parsePlaybookFile(report, name, files[name])
+		if !ok || !isPlaybookNode(root) {
+			// Files that are not playbooks, such as variable files, are not lists of plays.
+			continue
+		}
+		report.Playbooks = append(report.Playbooks, analyzePlaybook(report, files, name, root, shared, options.Inventory))
+	}
+	if len(report.Playbooks) == 0 {
+		report.addIssue(TemplateIssueSeverityError, PlaybookRuleNoPlaybooks, "", 0, "the folder '%s' contains no playbooks", report.Folder)
+	}
+	return report
+}
+
+// parsePlaybookFile returns the root node of a YAML file, reporting a syntax error if it cannot be parsed.
+func parsePlaybookFile(report *PlaybookReport, name string, data []byte) (*yaml.Node, bool) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		line := 0
+		if match := yamlErrorLinePattern.FindStringSubmatch(err.Error()); match != nil {
+			line, _ = strconv.Atoi(match[1])
+		}
+		report.addIssue(TemplateIssueSeverityError, TemplateRuleSyntax, name, line, "%s", err.Error())
+		return nil, false
+	}
+	if len(document.Content) == 0 {
+		return nil, false
+	}
+	return document.Content[0], true
+}
+
+// isPlaybookNode reports whether a YAML node is a list of plays: a list with an item that has hosts or imports a
+// playbook.
+func isPlaybookNode(root *yaml.Node) bool {
+	if root.Kind != yaml.SequenceNode {
+		return false
+	}
+	for _, item := range root.Content {
+		if yamlMappingValue(item, "hosts") != nil || playbookImport(item) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func analyzePlaybook(report *PlaybookReport, files templateFiles, name string, root *yaml.Node, shared map[string]bool, inventory *Inventory) Playbook {
+	playbook := Playbook{File: name}
+	defined := map[string]bool{}
+	collectPlaybookDefinitions(root, defined)
+
+	for i, play := range root.Content {
+		playName := fmt.Sprintf("play %d", i+1)
+		if play.Kind != yaml.MappingNode {
+			report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, play.Line, "%s is not a mapping", playName)
+			continue
+		}
+		if value := yamlMappingValue(play, "name"); value != nil && value.Kind == yaml.ScalarNode {
+			playName = fmt.Sprintf("play '%s'", value.Value)
+		}
+		if imported := playbookImport(play); imported != nil {
+			playbook.Imports = append(playbook.Imports, imported.Value)
+			if !strings.Contains(imported.Value, "{{") {
+				if _, ok := files[path.Join(path.Dir(name), imported.Value)]; !ok {
+					report.addIssue(TemplateIssueSeverityError, PlaybookRuleMissingImport, name, imported.Line, "the imported playbook '%s' does not exist", imported.Value)
+				}
+			}
+			continue
+		}
+
+		hosts := yamlMappingValue(play, "hosts")
+		pattern, ok := playbookHostPattern(hosts)
+		switch {
+		case hosts == nil:
+			report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, play.Line, "%s has no hosts", playName)
+		case !ok:
+			report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, hosts.Line, "the hosts of %s must be a pattern or a list of patterns", playName)
+		default:
+			playbook.Hosts = append(playbook.Hosts, pattern)
+			if inventory != nil && !strings.Contains(pattern, "{{") {
+				for _, term := range unmatchedHostPatternTerms(inventory, pattern) {
+					report.addIssue(TemplateIssueSeverityError, PlaybookRuleUnmatchedHosts, name, hosts.Line,
+						"the host pattern '%s' of %s matches no group or host of the inventory", term, playName)
+				}
+			}
+		}
+
+		for _, key := range append(slices.Clone(playbookTaskLists), "roles") {
+			value := yamlMappingValue(play, key)
+			if value == nil {
+				continue
+			}
+			if value.Kind != yaml.SequenceNode {
+				report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, value.Line, "the %s of %s must be a list", key, playName)
+				continue
+			}
+			for _, item := range value.Content {
+				if key != "roles" && item.Kind != yaml.MappingNode {
+					report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, item.Line, "a task of the %s of %s is not a mapping", key, playName)
+				}
+			}
+		}
+		if vars := yamlMappingValue(play, "vars"); vars != nil && vars.Kind != yaml.MappingNode {
+			report.addIssue(TemplateIssueSeverityError, PlaybookRuleInvalidPlay, name, vars.Line, "the vars of %s must be a mapping", playName)
+		}
+	}
+
+	required := map[string]bool{}
+	collectPlaybookReferences(root, func(variable string) {
+		if !defined[variable] && !shared[variable] && !strings.HasPrefix(variable, "ansible_") && !slices.Contains(playbookImplicitVariables, variable) {
+			required[variable] = true
+		}
+	})
+	playbook.RequiredVars = sortedKeys(required)
+	if len(playbook.RequiredVars) == 0 {
+		playbook.RequiredVars = nil
+	}
+	return playbook
+}
+
+// collectPlaybookDefinitions adds the variables that a playbook defines to defined: the keys of vars and set_fact,
+// and the names of vars_prompt, register and loop_control.loop_var.
+func collectPlaybookDefinitions(node *yaml.Node, defined map[string]bool) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			collectPlaybookDefinitions(item, defined)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			switch {
+			case (key == "vars" || key == "set_fact" || key == "ansible.builtin.set_fact") && value.Kind == yaml.MappingNode:
+				for j := 0; j < len(value.Content); j += 2 {
+					defined[value.Content[j].Value] = true
+				}
+			case (key == "register" || key == "loop_var") && value.Kind == yaml.ScalarNode:
+				defined[value.Value] = true
+			case key == "vars_prompt" && value.Kind == yaml.SequenceNode:
+				for _, prompt := range value.Content {
+					if promptName := yamlMappingValue(prompt, "name"); promptName != nil {
+						defined[promptName.Value] = true
+					}
+				}
+			}
+			collectPlaybookDefinitions(value, defined)
+		}
+	}
+}
+
+// collectPlaybookReferences calls reference with each variable that a Jinja expression of a playbook starts with,
+// unless the expression gives it a default. The values of conditions are not templated strings and are skipped.
+func collectPlaybookReferences(node *yaml.Node, reference func(variable string)) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		for _, match := range jinjaReferencePattern.FindAllStringSubmatch(node.Value, -1) {
+			rest := strings.TrimSpace(match[2])
+			if strings.HasPrefix(rest, "(") || jinjaDefaultFilterPattern.MatchString(rest) {
+				continue
+			}
+			reference(match[1])
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			collectPlaybookReferences(item, reference)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if !slices.Contains(playbookConditionKeys, node.Content[i].Value) {
+				collectPlaybookReferences(node.Content[i+1], reference)
+			}
+		}
+	}
+}
+
+// playbookImport returns the playbook that a play imports, if it is an import_playbook entry.
+func playbookImport(play *yaml.Node) *yaml.Node {
+	for _, key := range []string{"import_playbook", "ansible.builtin.import_playbook"} {
+		if value := yamlMappingValue(play, key); value != nil && value.Kind == yaml.ScalarNode {
+			return value
+		}
+	}
+	return nil
+}
+
+// playbookHostPattern returns the host pattern of the hosts of a play, which are a pattern or a list of patterns.
+func playbookHostPattern(hosts *yaml.Node) (string, bool) {
+	if hosts == nil {
+		return "", false
+	}
+	if hosts.Kind == yaml.ScalarNode {
+		return hosts.Value, hosts.Value != ""
+	}
+	if hosts.Kind != yaml.SequenceNode || len(hosts.Content) == 0 {
+		return "", false
+	}
+	patterns := make([]string, 0, len(hosts.Content))
+	for _, item := range hosts.Content {
+		if item.Kind != yaml.ScalarNode {
+			return "", false
+		}
+		patterns = append(patterns, item.Value)
+	}
+	return strings.Join(patterns, ","), true
+}
+
+// unmatchedHostPatternTerms returns the terms of a host pattern, such as "web:&prod:!db" or "web*,db", that match
+// no group or host of an inventory. Exclusions ("!db") are not checked.
+func unmatchedHostPatternTerms(inventory *Inventory, pattern string) (unmatched []string) {
+	names := map[string]bool{DynamicInventoryGroupAll: true, DynamicInventoryGroupUngrouped: true, "localhost": true}
+	addHosts := func(hosts []*InventoryHost) {
+		for _, host := range hosts {
+			expanded, _, err := expandInventoryHost(host.Name)
+			if err != nil {
+				expanded = []string{host.Name}
+			}
+			for _, name := range expanded {
+				names[name] = true
+			}
+		}
+	}
+	addHosts(inventory.Hosts)
+	for _, group := range inventory.Groups {
+		names[group.Name] = true
+		addHosts(group.Hosts)
+	}
+
+	separator := ":"
+	if strings.Contains(pattern, ",") {
+		separator = ","
+	}
+	for _, term := range strings.Split(pattern, separator) {
+		term = strings.TrimPrefix(strings.TrimSpace(term), "&")
+		if term == "" || term == "*" || strings.HasPrefix(term, "!") {
+			continue
+		}
+		if !hostPatternTermMatches(names, term) {
+			unmatched = append(unmatched, term)
+		}
+	}
+	return unmatched
+}
+
+func hostPatternTermMatches(names map[string]bool, term string) bool {
+	if strings.HasPrefix(term, "~") {
+		expression, err := regexp.Compile(term[1:])
+		if err != nil {
+			return false
+		}
+		for name := range names {
+			if expression.MatchString(name) {
+				return true
+			}
+		}
+		return false
+	}
+	term = playbookGroupSubscriptPattern.ReplaceAllString(term, "")
+	if names[term] {
+		return true
+	}
+	if strings.ContainsAny(term, "*?") {
+		for name := range names {
+			if matched, _ := path.Match(term, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// yamlMappingValue returns the value of a key of a YAML mapping, or nil.
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// inFolderTree reports whether a file is within folder or its subfolders.
+func inFolderTree(name string, folder string) bool {
+	folder = strings.Trim(folder, "/")
+	return folder == "" || strings.HasPrefix(name, folder+"/")
+}
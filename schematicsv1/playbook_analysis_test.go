@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 playbook analysis`, func() {
+	var dir string
+	var inventory *schematicsv1.Inventory
+
+	const siteYml = `
+- name: Configure web servers
+  hosts: webserverhost:&prod
+  vars:
+    http_port: 8080
+  vars_prompt:
+    - name: release
+  tasks:
+    - name: Install packages
+      ansible.builtin.package:
+        name: "{{ item }}"
+      loop: "{{ packages }}"
+    - name: Read the version
+      command: cat /etc/version
+      register: version_output
+    - set_fact:
+        deployed_version: "{{ version_output.stdout }}"
+    - debug:
+        msg: "{{ deployed_version }} on {{ inventory_hostname }} port {{ http_port }} ({{ ansible_distribution }})"
+    - template:
+        src: app.conf.j2
+        dest: "{{ app_dir }}/app.conf"
+        mode: "{{ file_mode | default('0644') }}"
+      when: enable_config and release != ""
+    - debug:
+        msg: "{{ lookup('env', 'HOME') }} {{ db_password }} {{ region_name }}"
+
+- hosts:
+    - db[0]
+    - "web*"
+  roles:
+    - common
+
+- import_playbook: other.yml
+`
+	const otherYml = `
+- hosts: "{{ target_hosts }}"
+  tasks:
+    - ping:
+`
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "playbook-analysis")
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{
+			"ansible/site.yml":              siteYml,
+			"ansible/other.yml":             otherYml,
+			"ansible/group_vars/all.yml":    "region_name: us-south\n",
+			"ansible/requirements.yml":      "collections:\n  - name: ibm.cloudcollection\n",
+			"ansible/vars/settings.yml":     "- not: a playbook\n",
+			"ansible/roles/common/x.yml":    "- hosts: nowhere\n",
+			"README.md":                     "docs",
+			"ansible/templates/app.conf.j2": "port={{ http_port }}",
+		})
+		inventory, err = schematicsv1.ParseInventory("[webserverhost]\nweb[01:02].example.com\n[prod:children]\nwebserverhost\n[db]\ndb.example.com db_password=secret\n")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`List the playbooks of a folder`, func() {
+		report, err := schematicsv1.AnalyzePlaybookDir(dir, &schematicsv1.PlaybookAnalysisOptions{Folder: "ansible", Inventory: inventory})
+		Expect(err).To(BeNil())
+		Expect(report.Issues).To(BeEmpty())
+		Expect(report.Valid()).To(BeTrue())
+		Expect(report.Folder).To(Equal("ansible"))
+		Expect(report.Playbooks).To(Equal([]schematicsv1.Playbook{
+			{File: "ansible/other.yml", Hosts: []string{"{{ target_hosts }}"}, RequiredVars: []string{"target_hosts"}},
+			{
+				File:         "ansible/site.yml",
+				Hosts:        []string{"webserverhost:&prod", "db[0],web*"},
+				RequiredVars: []string{"app_dir", "packages"},
+				Imports:      []string{"other.yml"},
+			},
+		}))
+
+		report, err = schematicsv1.AnalyzePlaybookDir(dir, &schematicsv1.PlaybookAnalysisOptions{Folder: "ansible"})
+		Expect(err).To(BeNil())
+		Expect(report.Playbooks[1].RequiredVars).To(Equal([]string{"app_dir", "db_password", "packages"}))
+	})
+	It(`Report invalid playbooks and unmatched host patterns`, func() {
+		writeFiles(dir, map[string]string{
+			"ansible/site.yml": `
+- name: No hosts
+  tasks: []
+- name: Wrong types
+  hosts: db
+  tasks:
+    - ping
+  roles: common
+  vars: [a, b]
+- hosts: "web:&staging:!db:~^app-"
+- hosts:
+    nested: mapping
+- import_playbook: missing.yml
+`,
+			"ansible/broken.yml": "- hosts: all\n  tasks:\n    - ping: {\n",
+		})
+		report, err := schematicsv1.AnalyzePlaybookDir(dir, &schematicsv1.PlaybookAnalysisOptions{Folder: "ansible", Inventory: inventory})
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeFalse())
+
+		var rules []string
+		var messages []string
+		for _, issue := range report.Issues {
+			rules = append(rules, issue.Rule)
+			messages = append(messages, issue.Message)
+		}
+		Expect(rules).To(Equal([]string{
+			schematicsv1.TemplateRuleSyntax,
+			schematicsv1.PlaybookRuleInvalidPlay,
+			schematicsv1.PlaybookRuleInvalidPlay,
+			schematicsv1.PlaybookRuleInvalidPlay,
+			schematicsv1.PlaybookRuleInvalidPlay,
+			schematicsv1.PlaybookRuleUnmatchedHosts,
+			schematicsv1.PlaybookRuleUnmatchedHosts,
+			schematicsv1.PlaybookRuleUnmatchedHosts,
+			schematicsv1.PlaybookRuleInvalidPlay,
+			schematicsv1.PlaybookRuleMissingImport,
+		}))
+		Expect(report.Issues[0].File).To(Equal("ansible/broken.yml"))
+		Expect(report.Issues[0].Line).To(BeNumerically(">", 0))
+		Expect(messages[1:]).To(Equal([]string{
+			"play 'No hosts' has no hosts",
+			"a task of the tasks of play 'Wrong types' is not a mapping",
+			"the roles of play 'Wrong types' must be a list",
+			"the vars of play 'Wrong types' must be a mapping",
+			"the host pattern 'web' of play 3 matches no group or host of the inventory",
+			"the host pattern 'staging' of play 3 matches no group or host of the inventory",
+			"the host pattern '~^app-' of play 3 matches no group or host of the inventory",
+			"the hosts of play 4 must be a pattern or a list of patterns",
+			"the imported playbook 'missing.yml' does not exist",
+		}))
+		Expect(report.Issues[1]).To(Equal(schematicsv1.TemplateValidationIssue{
+			Severity: schematicsv1.TemplateIssueSeverityError,
+			Rule:     schematicsv1.PlaybookRuleInvalidPlay,
+			File:     "ansible/site.yml",
+			Line:     2,
+			Message:  "play 'No hosts' has no hosts",
+		}))
+
+		report, err = schematicsv1.AnalyzePlaybookDir(dir, &schematicsv1.PlaybookAnalysisOptions{Folder: "missing"})
+		Expect(err).To(BeNil())
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.TemplateRuleFolderMissing))
+
+		report, err = schematicsv1.AnalyzePlaybookDir(dir, nil)
+		Expect(err).To(BeNil())
+		Expect(report.Playbooks).To(BeEmpty())
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.PlaybookRuleNoPlaybooks))
+	})
+	It(`Analyze an archive`, func() {
+		archive := &bytes.Buffer{}
+		_, err := schematicsv1.NewTemplateArchive(dir).WriteTo(archive)
+		Expect(err).To(BeNil())
+
+		report, err := schematicsv1.AnalyzePlaybookArchive(archive, &schematicsv1.PlaybookAnalysisOptions{Folder: "ansible", Inventory: inventory})
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeTrue())
+		Expect(report.Playbooks).To(HaveLen(2))
+
+		_, err = schematicsv1.AnalyzePlaybookArchive(bytes.NewReader([]byte("not a tar file at all")), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
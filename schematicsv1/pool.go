@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// ClientPoolAccount : the configuration of a single account in a ClientPool.
+type ClientPoolAccount struct {
+	// The options used to construct the account's client. Credentials and settings that are not set here
+	// are loaded from the external configuration named by Options.ServiceName, as with
+	// NewSchematicsV1UsingExternalConfig.
+	Options SchematicsV1Options
+
+	// The maximum rate of operations for the account, in requests per second. 0 means unlimited.
+	RequestsPerSecond float64
+
+	// The maximum number of operations that may exceed RequestsPerSecond in a burst.
+	Burst int
+}
+
+// AccountErrors : the errors returned by individual accounts during a fan-out operation, keyed by account.
+type AccountErrors map[string]error
+
+// Error returns a summary of the account errors.
+func (errs AccountErrors) Error() string {
+	return summarizeErrors("account", errs)
+}
+
+// ClientPool : a set of SchematicsV1 clients keyed by account or profile name.
+// Clients are constructed on first use. A ClientPool is safe for concurrent use.
+type ClientPool struct {
+	mutex    sync.RWMutex
+	accounts map[string]*clientPoolEntry
+}
+
+type clientPoolEntry struct {
+	config ClientPoolAccount
+	once   sync.Once
+	client *SchematicsV1
+	err    error
+}
+
+// NewClientPool : constructs an empty ClientPool.
+func NewClientPool() *ClientPool {
+	return &ClientPool{
+		accounts: make(map[string]*clientPoolEntry),
+	}
+}
+
+// AddAccount adds (or replaces) an account. Its client is constructed when it is first requested.
+func (pool *ClientPool) AddAccount(account string, config ClientPoolAccount) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	pool.accounts[account] = &clientPoolEntry{config: config}
+}
+
+// AddAccountsFromExternalConfig adds accounts whose credentials are read from external configuration.
+// The configuration of each account is looked up under the service name "schematics_<account>", so that,
+// for example, the account "dev" is configured by SCHEMATICS_DEV_APIKEY, SCHEMATICS_DEV_URL, etc.
+func (pool *ClientPool) AddAccountsFromExternalConfig(accounts ...string) {
+	for _, account := range accounts {
+		pool.AddAccount(account, ClientPoolAccount{
+			Options: SchematicsV1Options{
+				ServiceName: AccountServiceName(account),
+			},
+		})
+	}
+}
+
+// AccountServiceName returns the external configuration service name used for an account by
+// AddAccountsFromExternalConfig.
+func AccountServiceName(account string) string {
+	return DefaultServiceName + "_" + strings.ToLower(account)
+}
+
+// RemoveAccount removes an account from the pool.
+func (pool *ClientPool) RemoveAccount(account string) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	delete(pool.accounts, account)
+}
+
+// Accounts returns the accounts in the pool, in sorted order.
+func (pool *ClientPool) Accounts() []string {
+	pool.mutex.RLock()
+	defer pool.mutex.RUnlock()
+	return sortedKeys(pool.accounts)
+}
+
+// Client returns the client of an account, constructing it on first use.
+// A construction error is returned on every subsequent call for the account.
+func (pool *ClientPool) Client(account string) (*SchematicsV1, error) {
+	pool.mutex.RLock()
+	entry, ok := pool.accounts[account]
+	pool.mutex.RUnlock()
+	if !ok {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("account '%s' is not in the client pool", account), "pool-unknown-account", common.GetComponentInfo())
+	}
+
+	entry.once.Do(func() {
+		options := entry.config.Options
+		entry.client, entry.err = NewSchematicsV1UsingExternalConfig(&options)
+		if entry.err != nil {
+			entry.err = core.RepurposeSDKProblem(entry.err, "pool-client-error")
+			return
+		}
+		if entry.config.RequestsPerSecond > 0 {
+			entry.client.Use(NewRateLimitMiddleware(entry.config.RequestsPerSecond, entry.config.Burst))
+		}
+	})
+	return entry.client, entry.err
+}
+
+// ForEachAccount invokes call concurrently with the client of every account in the pool and returns the
+// successful results by account. If any account fails, including failures to construct its client,
+// the returned error is an AccountErrors.
+func ForEachAccount[T any](ctx context.Context, pool *ClientPool, call func(ctx context.Context, account string, client *SchematicsV1) (T, error)) (map[string]T, error) {
+	results, errs := concurrently(ctx, pool.Accounts(), func(ctx context.Context, account string) (result T, err error) {
+		client, err := pool.Client(account)
+		if err != nil {
+			return
+		}
+		return call(ctx, account, client)
+	})
+	if len(errs) > 0 {
+		return results, AccountErrors(errs)
+	}
+	return results, nil
+}
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 client pool`, func() {
+	var testServer *httptest.Server
+
+	// Map containing environment variables used in testing.
+	var testEnvironment map[string]string
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"count": 1, "limit": 100, "offset": 0, "workspaces": [{"id": "%s"}]}`, req.Header.Get("X-Account"))
+		}))
+		testEnvironment = map[string]string{
+			"SCHEMATICS_DEV_URL":        testServer.URL,
+			"SCHEMATICS_DEV_AUTH_TYPE":  "noauth",
+			"SCHEMATICS_PROD_URL":       testServer.URL,
+			"SCHEMATICS_PROD_AUTH_TYPE": "noauth",
+			"SCHEMATICS_BAD_AUTH_TYPE":  "someOtherAuth",
+		}
+		SetTestEnvironment(testEnvironment)
+	})
+	AfterEach(func() {
+		ClearTestEnvironment(testEnvironment)
+		testServer.Close()
+	})
+
+	It(`Construct clients lazily from external configuration`, func() {
+		pool := schematicsv1.NewClientPool()
+		pool.AddAccountsFromExternalConfig("dev", "prod")
+		Expect(pool.Accounts()).To(Equal([]string{"dev", "prod"}))
+		Expect(schematicsv1.AccountServiceName("Dev")).To(Equal("schematics_dev"))
+
+		var wg sync.WaitGroup
+		clients := make([]*schematicsv1.SchematicsV1, 10)
+		for i := range clients {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				client, err := pool.Client("dev")
+				Expect(err).To(BeNil())
+				clients[i] = client
+			}(i)
+		}
+		wg.Wait()
+		for _, client := range clients {
+			Expect(client).To(BeIdenticalTo(clients[0]))
+		}
+		Expect(clients[0].GetServiceURL()).To(Equal(testServer.URL))
+
+		_, err := pool.Client("unknown")
+		Expect(err).ToNot(BeNil())
+
+		pool.RemoveAccount("prod")
+		Expect(pool.Accounts()).To(Equal([]string{"dev"}))
+	})
+	It(`Construct clients from explicit options`, func() {
+		pool := schematicsv1.NewClientPool()
+		pool.AddAccount("custom", schematicsv1.ClientPoolAccount{
+			Options: schematicsv1.SchematicsV1Options{
+				ServiceName:   "schematics_dev",
+				URL:           "https://example.com/api",
+				Authenticator: &core.NoAuthAuthenticator{},
+			},
+			RequestsPerSecond: 100,
+			Burst:             10,
+		})
+
+		client, err := pool.Client("custom")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://example.com/api"))
+	})
+	It(`Run an operation across all accounts`, func() {
+		pool := schematicsv1.NewClientPool()
+		pool.AddAccountsFromExternalConfig("dev", "prod", "bad")
+
+		results, err := schematicsv1.ForEachAccount(context.Background(), pool, func(ctx context.Context, account string, client *schematicsv1.SchematicsV1) (*schematicsv1.WorkspaceResponseList, error) {
+			options := client.NewListWorkspacesOptions()
+			options.SetHeaders(map[string]string{"X-Account": account})
+			result, _, err := client.ListWorkspacesWithContext(ctx, options)
+			return result, err
+		})
+		Expect(results).To(HaveLen(2))
+		Expect(*results["dev"].Workspaces[0].ID).To(Equal("dev"))
+		Expect(*results["prod"].Workspaces[0].ID).To(Equal("prod"))
+
+		Expect(err).ToNot(BeNil())
+		accountErrors, ok := err.(schematicsv1.AccountErrors)
+		Expect(ok).To(BeTrue())
+		Expect(accountErrors).To(HaveLen(1))
+		Expect(accountErrors).To(HaveKey("bad"))
+		Expect(err.Error()).To(ContainSubstring("bad: "))
+	})
+})
@@ -0,0 +1,419 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Default values used by adaptive rate limiting.
+const (
+	defaultMinRequestsPerSecond = 0.1
+	defaultThrottlePause        = time.Second
+	recoveryFraction            = 0.1
+)
+
+// RateLimitBudget : the limits applied to a class of operations. A zero value imposes no limit.
+type RateLimitBudget struct {
+	// The maximum rate of operations, in requests per second. 0 means unlimited.
+	RequestsPerSecond float64
+
+	// The maximum number of operations that may exceed RequestsPerSecond in a burst. Defaults to 1.
+	Burst int
+
+	// The maximum number of operations in flight at the same time. 0 means unlimited.
+	MaxInFlight int
+}
+
+// RateLimiterOptions : the configuration of a RateLimiter.
+type RateLimiterOptions struct {
+	// The limits applied to all operations.
+	Overall RateLimitBudget
+
+	// The limits applied to read-only operations (see Operation.IsReadOnly), in addition to Overall.
+	Read RateLimitBudget
+
+	// The limits applied to operations that change resources, in addition to Overall.
+	Mutating RateLimitBudget
+
+	// If true, a 429 (Too Many Requests) response halves the request rate of the budgets that admitted the
+	// operation and pauses them for the duration of the Retry-After header (1 second if absent). The rate then
+	// recovers gradually with each successful operation.
+	//
+	// With EnableRateLimiting, this applies to every 429 response, including those that the service retries
+	// when retries are enabled with EnableRetries.
+	Adaptive bool
+
+	// The rate below which adaptive rate limiting does not go. Defaults to 0.1 requests per second.
+	MinRequestsPerSecond float64
+}
+
+// RateLimiter : a client-side rate limiter and concurrency governor for SchematicsV1 requests.
+// Requests wait, until their context is done, for a token from the token bucket and a slot in the
+// in-flight semaphore of each applicable budget. A RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	adaptive bool
+	overall  *operationBudget
+	read     *operationBudget
+	mutating *operationBudget
+}
+
+// NewRateLimiter : constructs a RateLimiter with the specified options.
+func NewRateLimiter(options *RateLimiterOptions) *RateLimiter {
+	if options == nil {
+		options = &RateLimiterOptions{}
+	}
+	minRate := options.MinRequestsPerSecond
+	if minRate <= 0 {
+		minRate = defaultMinRequestsPerSecond
+	}
+	return &RateLimiter{
+		adaptive: options.Adaptive,
+		overall:  newOperationBudget(options.Overall, minRate),
+		read:     newOperationBudget(options.Read, minRate),
+		mutating: newOperationBudget(options.Mutating, minRate),
+	}
+}
+
+// NewRateLimitMiddleware : returns a Middleware that limits operations to requestsPerSecond, allowing bursts of up
+// to burst operations. Operations wait for their turn until their context is done.
+// A requestsPerSecond of 0 or less disables the limit.
+func NewRateLimitMiddleware(requestsPerSecond float64, burst int) Middleware {
+	return NewRateLimiter(&RateLimiterOptions{
+		Overall: RateLimitBudget{
+			RequestsPerSecond: requestsPerSecond,
+			Burst:             burst,
+		},
+	}).Middleware()
+}
+
+// EnableRateLimiting applies a RateLimiter with the specified options to every HTTP request sent by this service
+// instance and returns it.
+//
+// The limiter wraps the transport of the service's HTTP client, below the retries made by the service when retries
+// are enabled with EnableRetries. Every attempt of an operation therefore takes a token and an in-flight slot, and
+// adaptive rate limiting sees every 429 response. Because it is part of the HTTP client, the limiter is shared with
+// clones made by Clone and must be enabled again after the client is replaced with SetHTTPClient.
+func (schematics *SchematicsV1) EnableRateLimiting(options *RateLimiterOptions) *RateLimiter {
+	limiter := NewRateLimiter(options)
+	client := *schematics.Service.GetHTTPClient()
+	client.Transport = limiter.Transport(client.Transport)
+	schematics.Service.SetHTTPClient(&client)
+	return limiter
+}
+
+// Middleware returns a Middleware that applies the limiter once to every operation. Unlike Transport, it does not
+// limit the retries made by the service, and adaptive rate limiting only sees the 429 responses of last attempts.
+func (limiter *RateLimiter) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, operation *Operation) (*core.DetailedResponse, error) {
+			budgets, err := limiter.acquire(ctx, operation.IsReadOnly())
+			if err != nil {
+				return nil, core.SDKErrorf(err, "", "rate-limit-wait-error", common.GetComponentInfo())
+			}
+			defer limiter.release(budgets)
+
+			response, err := next(ctx, operation)
+			if response != nil {
+				limiter.observe(budgets, response.StatusCode, response.Headers, err)
+			} else {
+				limiter.observe(budgets, 0, nil, err)
+			}
+			return response, err
+		}
+	}
+}
+
+// Transport returns an http.RoundTripper that applies the limiter to every request before passing it to next, or
+// to http.DefaultTransport if next is nil. Requests of SchematicsV1 operations are limited by the budgets of their
+// operation; other requests are read-only if they are GET or HEAD requests. A request keeps its in-flight slot until
+// its response body is closed.
+func (limiter *RateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &rateLimitTransport{limiter: limiter, next: next}
+}
+
+// rateLimitTransport : an http.RoundTripper that applies a RateLimiter to every request.
+type rateLimitTransport struct {
+	limiter *RateLimiter
+	next    http.RoundTripper
+}
+
+func (transport *rateLimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	readOnly := request.Method == http.MethodGet || request.Method == http.MethodHead
+	if operation := operationFromContext(request.Context()); operation != nil {
+		readOnly = operation.IsReadOnly()
+	}
+	budgets, err := transport.limiter.acquire(request.Context(), readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := transport.next.RoundTrip(request)
+	if response == nil {
+		transport.limiter.release(budgets)
+		transport.limiter.observe(budgets, 0, nil, err)
+		return response, err
+	}
+	transport.limiter.observe(budgets, response.StatusCode, response.Header, err)
+	response.Body = &rateLimitBody{ReadCloser: response.Body, release: func() { transport.limiter.release(budgets) }}
+	return response, err
+}
+
+// rateLimitBody : a response body that frees the in-flight slots of its request when it is closed.
+type rateLimitBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (body *rateLimitBody) Close() error {
+	body.once.Do(body.release)
+	return body.ReadCloser.Close()
+}
+
+// acquire waits for the budgets that apply to a request and returns them.
+func (limiter *RateLimiter) acquire(ctx context.Context, readOnly bool) ([]*operationBudget, error) {
+	budgets := []*operationBudget{limiter.overall, limiter.mutating}
+	if readOnly {
+		budgets[1] = limiter.read
+	}
+	for i, budget := range budgets {
+		if err := budget.acquire(ctx); err != nil {
+			limiter.release(budgets[:i])
+			return nil, err
+		}
+	}
+	return budgets, nil
+}
+
+// release frees the in-flight slots taken by acquire.
+func (limiter *RateLimiter) release(budgets []*operationBudget) {
+	for _, budget := range budgets {
+		budget.release()
+	}
+}
+
+// observe adapts the rate of the budgets to the outcome of a request if adaptive rate limiting is enabled.
+func (limiter *RateLimiter) observe(budgets []*operationBudget, statusCode int, headers http.Header, err error) {
+	if !limiter.adaptive {
+		return
+	}
+	if statusCode == http.StatusTooManyRequests {
+		pause := retryAfter(headers)
+		for _, budget := range budgets {
+			budget.throttle(pause)
+		}
+	} else if err == nil && statusCode < http.StatusBadRequest {
+		for _, budget := range budgets {
+			budget.recover()
+		}
+	}
+}
+
+// RequestsPerSecond returns the current request rates of the overall, read and mutating budgets.
+// With adaptive rate limiting these may be lower than the configured rates. 0 means unlimited.
+func (limiter *RateLimiter) RequestsPerSecond() (overall float64, read float64, mutating float64) {
+	return limiter.overall.rate(), limiter.read.rate(), limiter.mutating.rate()
+}
+
+// retryAfter returns the pause requested by the Retry-After header of a response.
+func retryAfter(headers http.Header) time.Duration {
+	value := headers.Get("Retry-After")
+	if value == "" {
+		return defaultThrottlePause
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return defaultThrottlePause
+}
+
+// operationBudget enforces a RateLimitBudget.
+type operationBudget struct {
+	bucket   *tokenBucket
+	inFlight chan struct{}
+}
+
+func newOperationBudget(budget RateLimitBudget, minRate float64) *operationBudget {
+	b := &operationBudget{}
+	if budget.RequestsPerSecond > 0 {
+		b.bucket = newTokenBucket(budget.RequestsPerSecond, budget.Burst)
+		if minRate < budget.RequestsPerSecond {
+			b.bucket.minRate = minRate
+		}
+	}
+	if budget.MaxInFlight > 0 {
+		b.inFlight = make(chan struct{}, budget.MaxInFlight)
+	}
+	return b
+}
+
+// acquire waits for a slot in the in-flight semaphore and then for a token.
+func (b *operationBudget) acquire(ctx context.Context) error {
+	if b.inFlight != nil {
+		select {
+		case b.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if b.bucket != nil {
+		if err := b.bucket.Wait(ctx); err != nil {
+			if b.inFlight != nil {
+				<-b.inFlight
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// release frees the in-flight slot taken by acquire.
+func (b *operationBudget) release() {
+	if b.inFlight != nil {
+		<-b.inFlight
+	}
+}
+
+func (b *operationBudget) throttle(pause time.Duration) {
+	if b.bucket != nil {
+		b.bucket.throttle(pause)
+	}
+}
+
+func (b *operationBudget) recover() {
+	if b.bucket != nil {
+		b.bucket.recover()
+	}
+}
+
+func (b *operationBudget) rate() float64 {
+	if b.bucket == nil {
+		return 0
+	}
+	b.bucket.mutex.Lock()
+	defer b.bucket.mutex.Unlock()
+	return b.bucket.rate
+}
+
+// tokenBucket is a token bucket rate limiter whose waits honor context cancellation.
+// Its rate can be lowered temporarily by throttle and is restored by recover.
+type tokenBucket struct {
+	mutex       sync.Mutex
+	rate        float64
+	maxRate     float64
+	minRate     float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(requestsPerSecond float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:    requestsPerSecond,
+		maxRate: requestsPerSecond,
+		minRate: requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (bucket *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay := bucket.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available and otherwise returns how long to wait for the next one.
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(bucket.pausedUntil) {
+		return bucket.pausedUntil.Sub(now)
+	}
+	bucket.tokens += now.Sub(bucket.last).Seconds() * bucket.rate
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / bucket.rate * float64(time.Second))
+}
+
+// throttle halves the rate, empties the bucket and pauses it for the specified duration.
+func (bucket *tokenBucket) throttle(pause time.Duration) {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.rate /= 2
+	if bucket.rate < bucket.minRate {
+		bucket.rate = bucket.minRate
+	}
+	bucket.tokens = 0
+	if until := time.Now().Add(pause); until.After(bucket.pausedUntil) {
+		bucket.pausedUntil = until
+	}
+	bucket.last = bucket.pausedUntil
+}
+
+// recover raises the rate by a fraction of the configured rate, up to the configured rate.
+func (bucket *tokenBucket) recover() {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.rate += bucket.maxRate * recoveryFraction
+	if bucket.rate > bucket.maxRate {
+		bucket.rate = bucket.maxRate
+	}
+}
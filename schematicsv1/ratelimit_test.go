@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 rate limiting`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "testString"}`)
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`NewRateLimitMiddleware(requestsPerSecond float64, burst int)`, func() {
+		It(`Delay operations beyond the burst`, func() {
+			schematicsService.Use(schematicsv1.NewRateLimitMiddleware(20, 2))
+
+			start := time.Now()
+			for i := 0; i < 4; i++ {
+				_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+				Expect(err).To(BeNil())
+			}
+			// Two operations use the burst, the other two wait 50ms each.
+			Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		})
+		It(`Stop waiting when the context is done`, func() {
+			schematicsService.Use(schematicsv1.NewRateLimitMiddleware(0.1, 1))
+
+			_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).To(BeNil())
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancelFunc()
+			_, _, err = schematicsService.GetWorkspaceWithContext(ctx, schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("deadline exceeded"))
+		})
+		It(`Do not limit when the rate is 0`, func() {
+			schematicsService.Use(schematicsv1.NewRateLimitMiddleware(0, 0))
+
+			for i := 0; i < 5; i++ {
+				_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+				Expect(err).To(BeNil())
+			}
+		})
+	})
+	Describe(`EnableRateLimiting(options *RateLimiterOptions)`, func() {
+		It(`Apply separate budgets to read and mutating operations`, func() {
+			schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+				Read:     schematicsv1.RateLimitBudget{RequestsPerSecond: 1000, Burst: 10},
+				Mutating: schematicsv1.RateLimitBudget{RequestsPerSecond: 0.1, Burst: 1},
+			})
+
+			_, err := schematicsService.DeleteAction(schematicsService.NewDeleteActionOptions("testString"))
+			Expect(err).To(BeNil())
+
+			// Reads are not held back by the exhausted mutating budget.
+			start := time.Now()
+			for i := 0; i < 5; i++ {
+				_, _, err = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+				Expect(err).To(BeNil())
+			}
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancelFunc()
+			_, err = schematicsService.DeleteActionWithContext(ctx, schematicsService.NewDeleteActionOptions("testString"))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Limit the number of operations in flight`, func() {
+			release := make(chan struct{})
+			testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				<-release
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "testString"}`)
+			})
+			schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+				Overall: schematicsv1.RateLimitBudget{MaxInFlight: 1},
+			})
+
+			done := make(chan error, 1)
+			go func() {
+				_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+				done <- err
+			}()
+			time.Sleep(20 * time.Millisecond)
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancelFunc()
+			_, _, err := schematicsService.GetWorkspaceWithContext(ctx, schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).ToNot(BeNil())
+
+			close(release)
+			Expect(<-done).To(BeNil())
+			_, _, err = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).To(BeNil())
+		})
+		It(`Slow down after a 429 response`, func() {
+			throttled := true
+			testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if throttled {
+					throttled = false
+					res.Header().Set("Retry-After", "0")
+					res.WriteHeader(429)
+					fmt.Fprint(res, `{"error": "too many requests"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "testString"}`)
+			})
+			limiter := schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+				Overall:  schematicsv1.RateLimitBudget{RequestsPerSecond: 100, Burst: 1},
+				Adaptive: true,
+			})
+
+			_, response, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(429))
+			overall, read, mutating := limiter.RequestsPerSecond()
+			Expect(overall).To(Equal(float64(50)))
+			Expect(read).To(BeZero())
+			Expect(mutating).To(BeZero())
+
+			_, _, err = schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).To(BeNil())
+			overall, _, _ = limiter.RequestsPerSecond()
+			Expect(overall).To(Equal(float64(60)))
+		})
+		It(`Slow down after every 429 response, including those that are retried`, func() {
+			var requests int32
+			throttledRequests := int32(1)
+			testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if atomic.AddInt32(&requests, 1) <= atomic.LoadInt32(&throttledRequests) {
+					res.Header().Set("Retry-After", "0")
+					res.WriteHeader(429)
+					fmt.Fprint(res, `{"error": "too many requests"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "testString"}`)
+			})
+			schematicsService.EnableRetries(1, time.Millisecond)
+			limiter := schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+				Overall:  schematicsv1.RateLimitBudget{RequestsPerSecond: 100, Burst: 1},
+				Adaptive: true,
+			})
+
+			_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+			overall, _, _ := limiter.RequestsPerSecond()
+			Expect(overall).To(Equal(float64(60)))
+
+			atomic.StoreInt32(&requests, 0)
+			atomic.StoreInt32(&throttledRequests, 2)
+			_, response, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(429))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(2)))
+			overall, _, _ = limiter.RequestsPerSecond()
+			Expect(overall).To(Equal(float64(15)))
+		})
+		It(`Take a token for every retry of an operation`, func() {
+			var requests int32
+			testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if atomic.AddInt32(&requests, 1) <= 2 {
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"error": "unavailable"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "testString"}`)
+			})
+			schematicsService.EnableRetries(2, time.Millisecond)
+			schematicsService.EnableRateLimiting(&schematicsv1.RateLimiterOptions{
+				Overall: schematicsv1.RateLimitBudget{RequestsPerSecond: 20, Burst: 1},
+			})
+
+			start := time.Now()
+			_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(3)))
+			// The first attempt uses the burst, the two retries wait 50ms each.
+			Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		})
+	})
+	Describe(`NewRateLimiter(options *RateLimiterOptions)`, func() {
+		It(`Do not limit without options`, func() {
+			limiter := schematicsv1.NewRateLimiter(nil)
+			schematicsService.Use(limiter.Middleware())
+
+			for i := 0; i < 5; i++ {
+				_, _, err := schematicsService.GetWorkspace(schematicsService.NewGetWorkspaceOptions("testString"))
+				Expect(err).To(BeNil())
+			}
+			overall, read, mutating := limiter.RequestsPerSecond()
+			Expect(overall).To(BeZero())
+			Expect(read).To(BeZero())
+			Expect(mutating).To(BeZero())
+		})
+	})
+})
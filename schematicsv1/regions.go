@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Constants associated with the endpoint types of a Schematics region.
+const (
+	EndpointTypePublic  = "public"
+	EndpointTypePrivate = "private"
+	EndpointTypeDirect  = "direct"
+)
+
+// RegionalEndpoint : the service URLs that serve a single Schematics region or geography.
+type RegionalEndpoint struct {
+	// The region (e.g. "us-south") or geography (e.g. "us") served by the endpoints.
+	Region string
+
+	// The geography that holds the data of workspaces and actions managed through the endpoints.
+	Geography string
+
+	// The public endpoint.
+	Public string
+
+	// The private endpoint, reachable from the IBM Cloud private network.
+	Private string
+
+	// The direct endpoint, reachable over IBM Cloud Direct Link.
+	Direct string
+}
+
+// URL returns the endpoint of the specified type, or "" if the type is unknown.
+func (endpoint RegionalEndpoint) URL(endpointType string) string {
+	switch endpointType {
+	case "", EndpointTypePublic:
+		return endpoint.Public
+	case EndpointTypePrivate:
+		return endpoint.Private
+	case EndpointTypeDirect:
+		return endpoint.Direct
+	}
+	return ""
+}
+
+// regionalEndpoints is the table of known Schematics endpoints.
+var regionalEndpoints = []RegionalEndpoint{
+	newRegionalEndpoint("us-south", "us"),
+	newRegionalEndpoint("us-east", "us"),
+	newRegionalEndpoint("eu-gb", "eu"),
+	newRegionalEndpoint("eu-de", "eu"),
+	newRegionalEndpoint("ca-tor", "ca"),
+	newRegionalEndpoint("us", "us"),
+	newRegionalEndpoint("eu", "eu"),
+}
+
+// defaultServiceGeography is the geography served by DefaultServiceURL.
+const defaultServiceGeography = "us"
+
+func newRegionalEndpoint(region string, geography string) RegionalEndpoint {
+	return RegionalEndpoint{
+		Region:    region,
+		Geography: geography,
+		Public:    fmt.Sprintf("https://%s.schematics.cloud.ibm.com", region),
+		Private:   fmt.Sprintf("https://private-%s.schematics.cloud.ibm.com", region),
+		Direct:    fmt.Sprintf("https://%s.direct.schematics.cloud.ibm.com", region),
+	}
+}
+
+// GetRegionalEndpoints returns the known Schematics regional and geography endpoints.
+func GetRegionalEndpoints() []RegionalEndpoint {
+	return append([]RegionalEndpoint(nil), regionalEndpoints...)
+}
+
+// GetServiceURLForRegionEndpoint returns the service URL of the specified endpoint type
+// (EndpointTypePublic, EndpointTypePrivate or EndpointTypeDirect) for a region or geography.
+func GetServiceURLForRegionEndpoint(region string, endpointType string) (string, error) {
+	for _, endpoint := range regionalEndpoints {
+		if endpoint.Region != region {
+			continue
+		}
+		if url := endpoint.URL(endpointType); url != "" {
+			return url, nil
+		}
+		return "", core.SDKErrorf(nil, fmt.Sprintf("endpoint type '%s' is not supported", endpointType), "invalid-endpoint-type", common.GetComponentInfo())
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("service URL for region '%s' not found", region), "invalid-region", common.GetComponentInfo())
+}
+
+// GetRegionForServiceURL returns the regional endpoint and endpoint type that serve the specified service URL.
+// DefaultServiceURL is reported as the public endpoint of the geography it routes to.
+func GetRegionForServiceURL(serviceURL string) (endpoint RegionalEndpoint, endpointType string, err error) {
+	serviceURL = strings.TrimSuffix(serviceURL, "/")
+	if serviceURL == DefaultServiceURL {
+		serviceURL, _ = GetServiceURLForRegionEndpoint(defaultServiceGeography, EndpointTypePublic)
+	}
+	for _, endpoint = range regionalEndpoints {
+		for _, endpointType = range []string{EndpointTypePublic, EndpointTypePrivate, EndpointTypeDirect} {
+			if endpoint.URL(endpointType) == serviceURL {
+				return
+			}
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("service URL '%s' is not a known regional endpoint", serviceURL), "unknown-service-url", common.GetComponentInfo())
+	return RegionalEndpoint{}, "", err
+}
+
+// GetLocationGeography returns the geography that holds the data of resources created in the specified location.
+func GetLocationGeography(location string) (string, error) {
+	for _, endpoint := range regionalEndpoints {
+		if endpoint.Region == location {
+			return endpoint.Geography, nil
+		}
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("location '%s' is not a known Schematics location", location), "invalid-location", common.GetComponentInfo())
+}
+
+// NewSchematicsV1ForRegion : constructs an instance of SchematicsV1 bound to the endpoint of the specified region.
+// The region may be qualified with an endpoint type, e.g. "private.us-south". Any URL set in options is ignored.
+func NewSchematicsV1ForRegion(options *SchematicsV1Options, region string) (service *SchematicsV1, err error) {
+	if options == nil {
+		err = core.SDKErrorf(nil, "options must be supplied", "condition-not-met", common.GetComponentInfo())
+		return
+	}
+	url, err := GetServiceURLForRegion(region)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "region-url-error")
+		return
+	}
+
+	regionalOptions := *options
+	regionalOptions.URL = url
+	return NewSchematicsV1(&regionalOptions)
+}
+
+// ValidateLocation checks that resources in the specified location are kept in the geography of the
+// endpoint used by this service instance. An error is returned if the location belongs to another geography,
+// or if either the location or the service URL is unknown.
+func (schematics *SchematicsV1) ValidateLocation(location string) error {
+	endpoint, _, err := GetRegionForServiceURL(schematics.GetServiceURL())
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "location-validation-error")
+	}
+	geography, err := GetLocationGeography(location)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "location-validation-error")
+	}
+	if geography != endpoint.Geography {
+		return core.SDKErrorf(nil, fmt.Sprintf("location '%s' is in geography '%s', but the service endpoint is in geography '%s'", location, geography, endpoint.Geography), "location-mismatch", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ValidateWorkspaceLocation checks the Location of a workspace against the endpoint used by this
+// service instance. See ValidateLocation.
+func (schematics *SchematicsV1) ValidateWorkspaceLocation(workspace *WorkspaceResponse) error {
+	if workspace == nil || workspace.Location == nil {
+		return core.SDKErrorf(nil, "workspace has no location", "missing-location", common.GetComponentInfo())
+	}
+	return schematics.ValidateLocation(*workspace.Location)
+}
+
+// splitRegionEndpointType splits a region qualified with an endpoint type (e.g. "private.us-south")
+// into its endpoint type and region.
+func splitRegionEndpointType(region string) (endpointType string, name string) {
+	if prefix, name, found := strings.Cut(region, "."); found {
+		return prefix, name
+	}
+	return EndpointTypePublic, region
+}
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 regions`, func() {
+	Describe(`GetServiceURLForRegionEndpoint(region string, endpointType string)`, func() {
+		It(`Resolve each endpoint type`, func() {
+			url, err := schematicsv1.GetServiceURLForRegionEndpoint("ca-tor", schematicsv1.EndpointTypePublic)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://ca-tor.schematics.cloud.ibm.com"))
+
+			url, err = schematicsv1.GetServiceURLForRegionEndpoint("eu-gb", schematicsv1.EndpointTypePrivate)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://private-eu-gb.schematics.cloud.ibm.com"))
+
+			url, err = schematicsv1.GetServiceURLForRegionEndpoint("us-east", schematicsv1.EndpointTypeDirect)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://us-east.direct.schematics.cloud.ibm.com"))
+		})
+		It(`Return an error for an unknown endpoint type`, func() {
+			url, err := schematicsv1.GetServiceURLForRegionEndpoint("us-south", "satellite")
+			Expect(err).ToNot(BeNil())
+			Expect(url).To(BeEmpty())
+		})
+	})
+	Describe(`GetRegionForServiceURL(serviceURL string)`, func() {
+		It(`Resolve regional and default endpoints`, func() {
+			endpoint, endpointType, err := schematicsv1.GetRegionForServiceURL("https://private-eu-de.schematics.cloud.ibm.com/")
+			Expect(err).To(BeNil())
+			Expect(endpoint.Region).To(Equal("eu-de"))
+			Expect(endpoint.Geography).To(Equal("eu"))
+			Expect(endpointType).To(Equal(schematicsv1.EndpointTypePrivate))
+
+			endpoint, endpointType, err = schematicsv1.GetRegionForServiceURL("https://eu-gb.direct.schematics.cloud.ibm.com")
+			Expect(err).To(BeNil())
+			Expect(endpoint.Region).To(Equal("eu-gb"))
+			Expect(endpointType).To(Equal(schematicsv1.EndpointTypeDirect))
+
+			endpoint, endpointType, err = schematicsv1.GetRegionForServiceURL(schematicsv1.DefaultServiceURL)
+			Expect(err).To(BeNil())
+			Expect(endpoint.Geography).To(Equal("us"))
+			Expect(endpointType).To(Equal(schematicsv1.EndpointTypePublic))
+
+			_, _, err = schematicsv1.GetRegionForServiceURL("https://example.com")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`NewSchematicsV1ForRegion(options *SchematicsV1Options, region string)`, func() {
+		It(`Construct a service client bound to a region`, func() {
+			options := &schematicsv1.SchematicsV1Options{
+				URL:           "https://ignored.example.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			}
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1ForRegion(options, "private.us-south")
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.GetServiceURL()).To(Equal("https://private-us-south.schematics.cloud.ibm.com"))
+			Expect(options.URL).To(Equal("https://ignored.example.com"))
+		})
+		It(`Return an error without options`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1ForRegion(nil, "us-south")
+			Expect(serviceErr).ToNot(BeNil())
+			Expect(serviceErr.Error()).To(Equal("options must be supplied"))
+			Expect(schematicsService).To(BeNil())
+		})
+		It(`Return an error for an unknown region`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1ForRegion(&schematicsv1.SchematicsV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+			}, "INVALID_REGION")
+			Expect(serviceErr).ToNot(BeNil())
+			Expect(schematicsService).To(BeNil())
+		})
+	})
+	Describe(`ValidateLocation(location string)`, func() {
+		It(`Accept locations in the endpoint geography only`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1ForRegion(&schematicsv1.SchematicsV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+			}, "eu-de")
+			Expect(serviceErr).To(BeNil())
+
+			Expect(schematicsService.ValidateLocation("eu-gb")).To(BeNil())
+			Expect(schematicsService.ValidateLocation("us-south")).ToNot(BeNil())
+			Expect(schematicsService.ValidateLocation("INVALID_LOCATION")).ToNot(BeNil())
+
+			workspace := &schematicsv1.WorkspaceResponse{Location: core.StringPtr("eu-de")}
+			Expect(schematicsService.ValidateWorkspaceLocation(workspace)).To(BeNil())
+			Expect(schematicsService.ValidateWorkspaceLocation(&schematicsv1.WorkspaceResponse{})).ToNot(BeNil())
+		})
+		It(`Return an error for an unknown service URL`, func() {
+			schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				URL:           "https://example.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(schematicsService.ValidateLocation("us-south")).ToNot(BeNil())
+		})
+	})
+})
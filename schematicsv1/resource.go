@@ -0,0 +1,720 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// listPageSize is the page size used to list all resources of a kind.
+const listPageSize = 100
+
+// Kinds of resources returned by Resource.Kind.
+const (
+	ResourceKindWorkspace     = "workspace"
+	ResourceKindAction        = "action"
+	ResourceKindInventory     = "inventory"
+	ResourceKindResourceQuery = "resource_query"
+	ResourceKindAgent         = "agent"
+	ResourceKindPolicy        = "policy"
+)
+
+// ResourceSummary : the fields that all kinds of resources have in common. Fields that a kind does not have
+// are empty.
+type ResourceSummary struct {
+	ID            string
+	Name          string
+	ResourceGroup string
+	Location      string
+	Tags          []string
+}
+
+// Resource : uniform create, read, update and delete access to one kind of Schematics resource, represented by T.
+//
+// Create and Update convert the resource into the kind's create or update options through their JSON
+// representation, so only fields that the options have are sent. For workspaces, actions and policies Update
+// changes the fields set in resource; for inventories, resource queries and agents it replaces the resource.
+//
+// Update and SetTags leave out the fields that the service owns, such as the status of a workspace. The service
+// returns secure values and credentials masked, so they also leave out lists of variables and environment values
+// that contain secure or hidden ones, and fields with sensitive names such as credentials, rather than send the
+// masks or drop the values. Set those with the update operation of the kind.
+type Resource[T any] interface {
+	// Kind returns the kind of resource, e.g. ResourceKindWorkspace.
+	Kind() string
+
+	// Summary returns the common fields of a resource.
+	Summary(resource *T) ResourceSummary
+
+	// List returns all resources of the kind, reading all pages. Fields that the service does not include in
+	// list responses are unset.
+	List(ctx context.Context) ([]T, error)
+
+	// Get returns a resource by ID.
+	Get(ctx context.Context, id string) (*T, error)
+
+	// Create creates a resource.
+	Create(ctx context.Context, resource *T) (*T, error)
+
+	// Update updates a resource by ID.
+	Update(ctx context.Context, id string, resource *T) (*T, error)
+
+	// Delete deletes a resource by ID.
+	Delete(ctx context.Context, id string) error
+
+	// SetTags replaces the tags of a resource. It fails for kinds without tags.
+	SetTags(ctx context.Context, id string, tags []string) error
+}
+
+// bulkResourceWorkers is the maximum number of resources that TagResources and DeleteResources change at once.
+const bulkResourceWorkers = 8
+
+// ResourceOptions : settings applied by a Resource adapter to the operations it performs.
+type ResourceOptions struct {
+	// The IAM refresh token required to delete workspaces.
+	RefreshToken string
+
+	// If true, deleting a workspace also destroys the cloud resources it manages.
+	DestroyResources bool
+
+	// If true, actions, inventories, resource queries and agents are deleted even if they are in use.
+	Force bool
+
+	// If true, deleting an action, inventory or resource query also deletes the jobs and resources that depend on it.
+	Propagate bool
+
+	// Custom request headers added to every operation.
+	Headers map[string]string
+}
+
+// ResourceErrors : the errors returned for individual resources during a bulk operation, keyed by resource ID.
+type ResourceErrors map[string]error
+
+// Error returns a summary of the resource errors.
+func (errs ResourceErrors) Error() string {
+	return summarizeErrors("resource", errs)
+}
+
+// ResourceSelector : selects resources by their common fields. A nil selector selects all resources.
+type ResourceSelector func(summary ResourceSummary) bool
+
+// SelectByTag returns a ResourceSelector that selects resources that have all the specified tags.
+func SelectByTag(tags ...string) ResourceSelector {
+	return func(summary ResourceSummary) bool {
+		for _, tag := range tags {
+			if !slices.Contains(summary.Tags, tag) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// SelectByName returns a ResourceSelector that selects resources with any of the specified names.
+func SelectByName(names ...string) ResourceSelector {
+	return func(summary ResourceSummary) bool {
+		return slices.Contains(names, summary.Name)
+	}
+}
+
+// SelectByResourceGroup returns a ResourceSelector that selects resources in the specified resource group.
+func SelectByResourceGroup(resourceGroup string) ResourceSelector {
+	return func(summary ResourceSummary) bool {
+		return summary.ResourceGroup == resourceGroup
+	}
+}
+
+// SelectByLocation returns a ResourceSelector that selects resources in the specified location.
+func SelectByLocation(location string) ResourceSelector {
+	return func(summary ResourceSummary) bool {
+		return summary.Location == location
+	}
+}
+
+// And returns a ResourceSelector that selects resources selected by both selectors.
+func (selector ResourceSelector) And(other ResourceSelector) ResourceSelector {
+	return func(summary ResourceSummary) bool {
+		return selector.matches(summary) && other.matches(summary)
+	}
+}
+
+func (selector ResourceSelector) matches(summary ResourceSummary) bool {
+	return selector == nil || selector(summary)
+}
+
+// SelectResources returns the resources of a kind that are selected by selector.
+func SelectResources[T any](ctx context.Context, resource Resource[T], selector ResourceSelector) ([]T, error) {
+	all, err := resource.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var selected []T
+	for i := range all {
+		if selector.matches(resource.Summary(&all[i])) {
+			selected = append(selected, all[i])
+		}
+	}
+	return selected, nil
+}
+
+// TagResources adds and removes tags on the resources selected by selector, and returns the IDs of the resources
+// whose tags changed. At most 8 resources are changed at once. If any resource fails, the returned error is a
+// ResourceErrors.
+func TagResources[T any](ctx context.Context, resource Resource[T], selector ResourceSelector, add []string, remove []string) ([]string, error) {
+	selected, err := SelectResources(ctx, resource, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string][]string{}
+	for i := range selected {
+		summary := resource.Summary(&selected[i])
+		tags := make([]string, 0, len(summary.Tags)+len(add))
+		for _, tag := range summary.Tags {
+			if !slices.Contains(remove, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		for _, tag := range add {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if !slices.Equal(tags, summary.Tags) {
+			changes[summary.ID] = tags
+		}
+	}
+
+	results, errs := concurrentlyLimited(ctx, sortedKeys(changes), bulkResourceWorkers, func(ctx context.Context, id string) (bool, error) {
+		return true, resource.SetTags(ctx, id, changes[id])
+	})
+	tagged := sortedKeys(results)
+	if len(errs) > 0 {
+		return tagged, ResourceErrors(errs)
+	}
+	return tagged, nil
+}
+
+// DeleteResources deletes the resources selected by selector and returns the IDs of the deleted resources.
+// The selector is required. At most 8 resources are deleted at once. If any resource fails, the returned error is
+// a ResourceErrors.
+func DeleteResources[T any](ctx context.Context, resource Resource[T], selector ResourceSelector) ([]string, error) {
+	if selector == nil {
+		return nil, core.SDKErrorf(nil, "a selector is required to delete resources", "resource-missing-selector", common.GetComponentInfo())
+	}
+	selected, err := SelectResources(ctx, resource, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(selected))
+	for i := range selected {
+		ids = append(ids, resource.Summary(&selected[i]).ID)
+	}
+	results, errs := concurrentlyLimited(ctx, ids, bulkResourceWorkers, func(ctx context.Context, id string) (bool, error) {
+		return true, resource.Delete(ctx, id)
+	})
+	deleted := sortedKeys(results)
+	if len(errs) > 0 {
+		return deleted, ResourceErrors(errs)
+	}
+	return deleted, nil
+}
+
+// resourceExport is the document written by ExportResources.
+type resourceExport[T any] struct {
+	Kind      string `json:"kind"`
+	Resources []*T   `json:"resources"`
+}
+
+// ExportResources writes the full definitions of the resources selected by selector to writer as a JSON document
+// of the form {"kind": "...", "resources": [...]}.
+func ExportResources[T any](ctx context.Context, resource Resource[T], selector ResourceSelector, writer io.Writer) error {
+	selected, err := SelectResources(ctx, resource, selector)
+	if err != nil {
+		return err
+	}
+
+	export := resourceExport[T]{Kind: resource.Kind(), Resources: make([]*T, 0, len(selected))}
+	for i := range selected {
+		full, err := resource.Get(ctx, resource.Summary(&selected[i]).ID)
+		if err != nil {
+			return err
+		}
+		export.Resources = append(export.Resources, full)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(export); err != nil {
+		return core.SDKErrorf(err, "", "resource-export-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Workspaces returns a Resource adapter for workspaces.
+func (schematics *SchematicsV1) Workspaces(options *ResourceOptions) Resource[WorkspaceResponse] {
+	options = resourceOptions(options)
+	return &resourceAdapter[WorkspaceResponse, WorkspaceResponse, CreateWorkspaceOptions, UpdateWorkspaceOptions]{
+		kind:     ResourceKindWorkspace,
+		readOnly: []string{"workspace_status", "workspace_status_msg"},
+		summary: func(w *WorkspaceResponse) ResourceSummary {
+			return newResourceSummary(w.ID, w.Name, w.ResourceGroup, w.Location, w.Tags)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]WorkspaceResponse, *int64, error) {
+			list, _, err := schematics.ListWorkspacesWithContext(ctx, &ListWorkspacesOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Workspaces, list.Count, nil
+		},
+		get: func(ctx context.Context, id string) (*WorkspaceResponse, error) {
+			result, _, err := schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (*WorkspaceResponse, error) {
+			createWorkspaceOptions.Headers = options.Headers
+			result, _, err := schematics.CreateWorkspaceWithContext(ctx, createWorkspaceOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, updateWorkspaceOptions *UpdateWorkspaceOptions) (*WorkspaceResponse, error) {
+			updateWorkspaceOptions.WID = &id
+			updateWorkspaceOptions.Headers = options.Headers
+			result, _, err := schematics.UpdateWorkspaceWithContext(ctx, updateWorkspaceOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			deleteWorkspaceOptions := schematics.NewDeleteWorkspaceOptions(id, options.RefreshToken)
+			if options.DestroyResources {
+				deleteWorkspaceOptions.SetDestroyResources("true")
+			}
+			deleteWorkspaceOptions.Headers = options.Headers
+			_, _, err := schematics.DeleteWorkspaceWithContext(ctx, deleteWorkspaceOptions)
+			return err
+		},
+	}
+}
+
+// Actions returns a Resource adapter for actions.
+func (schematics *SchematicsV1) Actions(options *ResourceOptions) Resource[Action] {
+	options = resourceOptions(options)
+	return &resourceAdapter[Action, ActionLite, CreateActionOptions, UpdateActionOptions]{
+		kind: ResourceKindAction,
+		summary: func(a *Action) ResourceSummary {
+			return newResourceSummary(a.ID, a.Name, a.ResourceGroup, a.Location, a.Tags)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]ActionLite, *int64, error) {
+			list, _, err := schematics.ListActionsWithContext(ctx, &ListActionsOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Actions, list.TotalCount, nil
+		},
+		get: func(ctx context.Context, id string) (*Action, error) {
+			result, _, err := schematics.GetActionWithContext(ctx, &GetActionOptions{ActionID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createActionOptions *CreateActionOptions) (*Action, error) {
+			createActionOptions.Headers = options.Headers
+			result, _, err := schematics.CreateActionWithContext(ctx, createActionOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, updateActionOptions *UpdateActionOptions) (*Action, error) {
+			updateActionOptions.ActionID = &id
+			updateActionOptions.Headers = options.Headers
+			result, _, err := schematics.UpdateActionWithContext(ctx, updateActionOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			_, err := schematics.DeleteActionWithContext(ctx, &DeleteActionOptions{
+				ActionID:  &id,
+				Force:     &options.Force,
+				Propagate: &options.Propagate,
+				Headers:   options.Headers,
+			})
+			return err
+		},
+	}
+}
+
+// Inventories returns a Resource adapter for inventories. Inventories have no tags.
+func (schematics *SchematicsV1) Inventories(options *ResourceOptions) Resource[InventoryResourceRecord] {
+	options = resourceOptions(options)
+	return &resourceAdapter[InventoryResourceRecord, InventoryResourceRecord, CreateInventoryOptions, ReplaceInventoryOptions]{
+		kind:    ResourceKindInventory,
+		replace: true,
+		summary: func(i *InventoryResourceRecord) ResourceSummary {
+			return newResourceSummary(i.ID, i.Name, i.ResourceGroup, i.Location, nil)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]InventoryResourceRecord, *int64, error) {
+			list, _, err := schematics.ListInventoriesWithContext(ctx, &ListInventoriesOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Inventories, list.TotalCount, nil
+		},
+		get: func(ctx context.Context, id string) (*InventoryResourceRecord, error) {
+			result, _, err := schematics.GetInventoryWithContext(ctx, &GetInventoryOptions{InventoryID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createInventoryOptions *CreateInventoryOptions) (*InventoryResourceRecord, error) {
+			createInventoryOptions.Headers = options.Headers
+			result, _, err := schematics.CreateInventoryWithContext(ctx, createInventoryOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, replaceInventoryOptions *ReplaceInventoryOptions) (*InventoryResourceRecord, error) {
+			replaceInventoryOptions.InventoryID = &id
+			replaceInventoryOptions.Headers = options.Headers
+			result, _, err := schematics.ReplaceInventoryWithContext(ctx, replaceInventoryOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			_, err := schematics.DeleteInventoryWithContext(ctx, &DeleteInventoryOptions{
+				InventoryID: &id,
+				Force:       &options.Force,
+				Propagate:   &options.Propagate,
+				Headers:     options.Headers,
+			})
+			return err
+		},
+	}
+}
+
+// ResourceQueries returns a Resource adapter for resource queries. Resource queries have no resource group,
+// location or tags.
+func (schematics *SchematicsV1) ResourceQueries(options *ResourceOptions) Resource[ResourceQueryRecord] {
+	options = resourceOptions(options)
+	return &resourceAdapter[ResourceQueryRecord, ResourceQueryRecord, CreateResourceQueryOptions, ReplaceResourcesQueryOptions]{
+		kind:    ResourceKindResourceQuery,
+		replace: true,
+		summary: func(q *ResourceQueryRecord) ResourceSummary {
+			return newResourceSummary(q.ID, q.Name, nil, nil, nil)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]ResourceQueryRecord, *int64, error) {
+			list, _, err := schematics.ListResourceQueryWithContext(ctx, &ListResourceQueryOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.ResourceQueries, list.TotalCount, nil
+		},
+		get: func(ctx context.Context, id string) (*ResourceQueryRecord, error) {
+			result, _, err := schematics.GetResourcesQueryWithContext(ctx, &GetResourcesQueryOptions{QueryID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createResourceQueryOptions *CreateResourceQueryOptions) (*ResourceQueryRecord, error) {
+			createResourceQueryOptions.Headers = options.Headers
+			result, _, err := schematics.CreateResourceQueryWithContext(ctx, createResourceQueryOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, replaceResourcesQueryOptions *ReplaceResourcesQueryOptions) (*ResourceQueryRecord, error) {
+			replaceResourcesQueryOptions.QueryID = &id
+			replaceResourcesQueryOptions.Headers = options.Headers
+			result, _, err := schematics.ReplaceResourcesQueryWithContext(ctx, replaceResourcesQueryOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			_, err := schematics.DeleteResourcesQueryWithContext(ctx, &DeleteResourcesQueryOptions{
+				QueryID:   &id,
+				Force:     &options.Force,
+				Propagate: &options.Propagate,
+				Headers:   options.Headers,
+			})
+			return err
+		},
+	}
+}
+
+// Agents returns a Resource adapter for agents. The location of an agent is its Schematics location.
+func (schematics *SchematicsV1) Agents(options *ResourceOptions) Resource[AgentData] {
+	options = resourceOptions(options)
+	return &resourceAdapter[AgentData, AgentDataLite, CreateAgentDataOptions, UpdateAgentDataOptions]{
+		kind:     ResourceKindAgent,
+		replace:  true,
+		readOnly: []string{"agent_kpi"},
+		summary: func(a *AgentData) ResourceSummary {
+			return newResourceSummary(a.ID, a.Name, a.ResourceGroup, a.SchematicsLocation, a.Tags)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]AgentDataLite, *int64, error) {
+			list, _, err := schematics.ListAgentDataWithContext(ctx, &ListAgentDataOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Agents, list.TotalCount, nil
+		},
+		get: func(ctx context.Context, id string) (*AgentData, error) {
+			result, _, err := schematics.GetAgentDataWithContext(ctx, &GetAgentDataOptions{AgentID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createAgentDataOptions *CreateAgentDataOptions) (*AgentData, error) {
+			createAgentDataOptions.Headers = options.Headers
+			result, _, err := schematics.CreateAgentDataWithContext(ctx, createAgentDataOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, updateAgentDataOptions *UpdateAgentDataOptions) (*AgentData, error) {
+			updateAgentDataOptions.AgentID = &id
+			updateAgentDataOptions.Headers = options.Headers
+			result, _, err := schematics.UpdateAgentDataWithContext(ctx, updateAgentDataOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			_, err := schematics.DeleteAgentDataWithContext(ctx, &DeleteAgentDataOptions{
+				AgentID: &id,
+				Force:   &options.Force,
+				Headers: options.Headers,
+			})
+			return err
+		},
+	}
+}
+
+// Policies returns a Resource adapter for policies.
+func (schematics *SchematicsV1) Policies(options *ResourceOptions) Resource[Policy] {
+	options = resourceOptions(options)
+	return &resourceAdapter[Policy, PolicyLite, CreatePolicyOptions, UpdatePolicyOptions]{
+		kind: ResourceKindPolicy,
+		summary: func(p *Policy) ResourceSummary {
+			return newResourceSummary(p.ID, p.Name, p.ResourceGroup, p.Location, p.Tags)
+		},
+		list: func(ctx context.Context, offset int64, limit int64) ([]PolicyLite, *int64, error) {
+			list, _, err := schematics.ListPolicyWithContext(ctx, &ListPolicyOptions{Offset: &offset, Limit: &limit, Headers: options.Headers})
+			if err != nil {
+				return nil, nil, err
+			}
+			return list.Policies, list.TotalCount, nil
+		},
+		get: func(ctx context.Context, id string) (*Policy, error) {
+			result, _, err := schematics.GetPolicyWithContext(ctx, &GetPolicyOptions{PolicyID: &id, Headers: options.Headers})
+			return result, err
+		},
+		create: func(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (*Policy, error) {
+			createPolicyOptions.Headers = options.Headers
+			result, _, err := schematics.CreatePolicyWithContext(ctx, createPolicyOptions)
+			return result, err
+		},
+		update: func(ctx context.Context, id string, updatePolicyOptions *UpdatePolicyOptions) (*Policy, error) {
+			updatePolicyOptions.PolicyID = &id
+			updatePolicyOptions.Headers = options.Headers
+			result, _, err := schematics.UpdatePolicyWithContext(ctx, updatePolicyOptions)
+			return result, err
+		},
+		delete: func(ctx context.Context, id string) error {
+			_, err := schematics.DeletePolicyWithContext(ctx, &DeletePolicyOptions{PolicyID: &id, Headers: options.Headers})
+			return err
+		},
+	}
+}
+
+// resourceAdapter implements Resource[T] for a kind whose list operation returns L, create operation takes C and
+// update operation takes U.
+type resourceAdapter[T any, L any, C any, U any] struct {
+	kind string
+
+	// replace is true if the update operation replaces the whole resource.
+	replace bool
+
+	// readOnly are the JSON names of fields of T that the update operation has but the service owns.
+	readOnly []string
+
+	summary func(resource *T) ResourceSummary
+	list    func(ctx context.Context, offset int64, limit int64) ([]L, *int64, error)
+	get     func(ctx context.Context, id string) (*T, error)
+	create  func(ctx context.Context, options *C) (*T, error)
+	update  func(ctx context.Context, id string, options *U) (*T, error)
+	delete  func(ctx context.Context, id string) error
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Kind() string {
+	return adapter.kind
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Summary(resource *T) ResourceSummary {
+	return adapter.summary(resource)
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) List(ctx context.Context) ([]T, error) {
+	items, err := listAll(ctx, adapter.list)
+	if err != nil {
+		return nil, err
+	}
+	if resources, ok := any(items).([]T); ok {
+		return resources, nil
+	}
+	resources := make([]T, len(items))
+	for i := range items {
+		if err := convertResource(&items[i], &resources[i]); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Get(ctx context.Context, id string) (*T, error) {
+	return adapter.get(ctx, id)
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Create(ctx context.Context, resource *T) (*T, error) {
+	options := new(C)
+	if err := convertResource(resource, options); err != nil {
+		return nil, err
+	}
+	return adapter.create(ctx, options)
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Update(ctx context.Context, id string, resource *T) (*T, error) {
+	options := new(U)
+	if err := adapter.convertForUpdate(resource, options); err != nil {
+		return nil, err
+	}
+	return adapter.update(ctx, id, options)
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) Delete(ctx context.Context, id string) error {
+	return adapter.delete(ctx, id)
+}
+
+func (adapter *resourceAdapter[T, L, C, U]) SetTags(ctx context.Context, id string, tags []string) error {
+	options := new(U)
+	field := reflect.ValueOf(options).Elem().FieldByName("Tags")
+	if !field.IsValid() {
+		return core.SDKErrorf(nil, fmt.Sprintf("resources of kind '%s' have no tags", adapter.kind), "resource-tags-unsupported", common.GetComponentInfo())
+	}
+
+	if adapter.replace {
+		current, err := adapter.get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := adapter.convertForUpdate(current, options); err != nil {
+			return err
+		}
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	field.Set(reflect.ValueOf(tags))
+	_, err := adapter.update(ctx, id, options)
+	return err
+}
+
+// convertForUpdate copies the fields of a resource to the options of the update operation, except the fields that
+// the service owns and those that stripSecrets removes.
+func (adapter *resourceAdapter[T, L, C, U]) convertForUpdate(resource *T, options *U) error {
+	var value interface{}
+	if err := convertResource(resource, &value); err != nil {
+		return err
+	}
+	if fields, ok := value.(map[string]interface{}); ok {
+		for _, name := range adapter.readOnly {
+			delete(fields, name)
+		}
+	}
+	return convertResource(stripSecrets(value), options)
+}
+
+// resourceOptions returns options, or empty options if options is nil.
+func resourceOptions(options *ResourceOptions) *ResourceOptions {
+	if options == nil {
+		return &ResourceOptions{}
+	}
+	return options
+}
+
+func newResourceSummary(id, name, resourceGroup, location *string, tags []string) ResourceSummary {
+	return ResourceSummary{
+		ID:            core.StringNilMapper(id),
+		Name:          core.StringNilMapper(name),
+		ResourceGroup: core.StringNilMapper(resourceGroup),
+		Location:      core.StringNilMapper(location),
+		Tags:          tags,
+	}
+}
+
+// convertResource copies the fields of source to the fields of target that have the same JSON name.
+func convertResource(source interface{}, target interface{}) error {
+	data, err := json.Marshal(source)
+	if err == nil {
+		err = json.Unmarshal(data, target)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "resource-conversion-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// stripSecrets removes the lists of variables and environment values that contain secure or hidden ones, and the
+// properties with sensitive names, from a JSON value. Whole lists are removed because the service replaces a list
+// that is sent, so sending it without the secure values would delete them.
+func stripSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		protected := false
+		forEachProtectedEnvValue(v, func(map[string]interface{}, string) {
+			protected = true
+		})
+		if protected {
+			delete(v, "env_values")
+			delete(v, "env_values_metadata")
+		}
+		for name, property := range v {
+			if sensitiveNamePattern.MatchString(name) || containsSecureVariable(property) {
+				delete(v, name)
+			} else {
+				stripSecrets(property)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			stripSecrets(item)
+		}
+	}
+	return value
+}
+
+// containsSecureVariable reports whether a JSON value is a list with a secure or hidden variable.
+func containsSecureVariable(value interface{}) bool {
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		if variable, ok := item.(map[string]interface{}); ok && isSecureVariable(variable) {
+			return true
+		}
+	}
+	return false
+}
+
+// listAll reads all pages of a list operation.
+func listAll[L any](ctx context.Context, list func(ctx context.Context, offset int64, limit int64) ([]L, *int64, error)) ([]L, error) {
+	var all []L
+	for offset := int64(0); ; {
+		items, total, err := list(ctx, offset, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		offset += int64(len(items))
+		if len(items) < listPageSize || (total != nil && offset >= *total) {
+			return all, nil
+		}
+	}
+}
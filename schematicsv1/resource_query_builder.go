@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Resource types of resource queries. The API only defines vsi; see ResourceQueryFields to use other types.
+const (
+	ResourceQueryTypeVsi = ResourceQueryRecord_Type_Vsi
+)
+
+// ResourceQueryCondition : the name of a query condition (ResourceQueryParam.Name) of a resource query.
+type ResourceQueryCondition string
+
+// Conditions of resource queries. Every query must select a workspace by ID or name.
+const (
+	ResourceQueryConditionWorkspaceID   ResourceQueryCondition = "workspace-id"
+	ResourceQueryConditionWorkspaceName ResourceQueryCondition = "workspace-name"
+	ResourceQueryConditionResourceGroup ResourceQueryCondition = "resource-group"
+	ResourceQueryConditionResourceName  ResourceQueryCondition = "resource-name"
+	ResourceQueryConditionRegion        ResourceQueryCondition = "region"
+	ResourceQueryConditionTag           ResourceQueryCondition = "tag"
+)
+
+// ResourceQueryField : a field selected by a resource query (ResourceQuery.QuerySelect).
+type ResourceQueryField string
+
+// Fields selected by resource queries.
+const (
+	ResourceQueryFieldID            ResourceQueryField = "id"
+	ResourceQueryFieldName          ResourceQueryField = "name"
+	ResourceQueryFieldResourceGroup ResourceQueryField = "resource_group"
+	ResourceQueryFieldRegion        ResourceQueryField = "region"
+	ResourceQueryFieldTags          ResourceQueryField = "tags"
+	ResourceQueryFieldZone          ResourceQueryField = "zone"
+	ResourceQueryFieldProfile       ResourceQueryField = "profile"
+	ResourceQueryFieldIPv4          ResourceQueryField = "ipv4_address"
+	ResourceQueryFieldFloatingIP    ResourceQueryField = "floating_ip"
+)
+
+// ResourceQueryConditions are the valid conditions of each query type (ResourceQuery.QueryType), and whether a
+// condition may be repeated. Add entries to validate conditions that the SDK does not know yet.
+var ResourceQueryConditions = map[string]map[ResourceQueryCondition]bool{
+	ResourceQuery_QueryType_Workspaces: {
+		ResourceQueryConditionWorkspaceID:   false,
+		ResourceQueryConditionWorkspaceName: false,
+		ResourceQueryConditionResourceGroup: false,
+		ResourceQueryConditionResourceName:  false,
+		ResourceQueryConditionRegion:        false,
+		ResourceQueryConditionTag:           true,
+	},
+}
+
+// ResourceQueryFields are the resource types that resource queries may have, with the fields that queries of each
+// type may select. It only contains the types that the API defines; add an entry to register a resource type that
+// the service supports but the SDK does not know yet, or a field to an existing entry.
+var ResourceQueryFields = map[string][]ResourceQueryField{
+	ResourceQueryTypeVsi: {
+		ResourceQueryFieldID, ResourceQueryFieldName, ResourceQueryFieldResourceGroup, ResourceQueryFieldRegion, ResourceQueryFieldTags,
+		ResourceQueryFieldZone, ResourceQueryFieldProfile, ResourceQueryFieldIPv4, ResourceQueryFieldFloatingIP,
+	},
+}
+
+// ResourceQueryBuilder : builds the queries of a resource query definition, for CreateResourceQuery and
+// ReplaceResourcesQuery. For example:
+//
+//	options, err := schematicsv1.VSIQuery().
+//		Named("web-servers").
+//		InWorkspace(workspaceID).
+//		InResourceGroup("default").
+//		WithTag("web").
+//		Select(schematicsv1.ResourceQueryFieldIPv4, schematicsv1.ResourceQueryFieldName).
+//		NewCreateResourceQueryOptions()
+//
+// Conditions and selected fields apply to the current query; Or starts another query of the same definition.
+// The builder checks the definition when the options are created.
+type ResourceQueryBuilder struct {
+	resourceType string
+	name         string
+	queries      []ResourceQuery
+}
+
+// NewResourceQueryBuilder : returns a ResourceQueryBuilder for a resource type (ResourceQueryType* or a type
+// registered in ResourceQueryFields), whose first query is a workspaces query.
+func NewResourceQueryBuilder(resourceType string) *ResourceQueryBuilder {
+	builder := &ResourceQueryBuilder{resourceType: resourceType}
+	return builder.Or()
+}
+
+// VSIQuery : returns a ResourceQueryBuilder for virtual server instances.
+func VSIQuery() *ResourceQueryBuilder {
+	return NewResourceQueryBuilder(ResourceQueryTypeVsi)
+}
+
+// Named sets the name of the resource query definition.
+func (builder *ResourceQueryBuilder) Named(name string) *ResourceQueryBuilder {
+	builder.name = name
+	return builder
+}
+
+// Or starts another workspaces query of the definition.
+func (builder *ResourceQueryBuilder) Or() *ResourceQueryBuilder {
+	builder.queries = append(builder.queries, ResourceQuery{QueryType: core.StringPtr(ResourceQuery_QueryType_Workspaces)})
+	return builder
+}
+
+// Where adds a condition to the current query.
+func (builder *ResourceQueryBuilder) Where(condition ResourceQueryCondition, value string) *ResourceQueryBuilder {
+	query := &builder.queries[len(builder.queries)-1]
+	query.QueryCondition = append(query.QueryCondition, ResourceQueryParam{Name: core.StringPtr(string(condition)), Value: core.StringPtr(value)})
+	return builder
+}
+
+// InWorkspace restricts the current query to the resources of a workspace.
+func (builder *ResourceQueryBuilder) InWorkspace(workspaceID string) *ResourceQueryBuilder {
+	return builder.Where(ResourceQueryConditionWorkspaceID, workspaceID)
+}
+
+// InWorkspaceNamed restricts the current query to the resources of the workspace with the specified name.
+func (builder *ResourceQueryBuilder) InWorkspaceNamed(workspaceName string) *ResourceQueryBuilder {
+	return builder.Where(ResourceQueryConditionWorkspaceName, workspaceName)
+}
+
+// InResourceGroup restricts the current query to the resources of a resource group.
+func (builder *ResourceQueryBuilder) InResourceGroup(resourceGroup string) *ResourceQueryBuilder {
+	return builder.Where(ResourceQueryConditionResourceGroup, resourceGroup)
+}
+
+// InRegion restricts the current query to the resources of a region.
+func (builder *ResourceQueryBuilder) InRegion(region string) *ResourceQueryBuilder {
+	return builder.Where(ResourceQueryConditionRegion, region)
+}
+
+// WithResourceName restricts the current query to the resources with the specified name.
+func (builder *ResourceQueryBuilder) WithResourceName(name string) *ResourceQueryBuilder {
+	return builder.Where(ResourceQueryConditionResourceName, name)
+}
+
+// WithTag restricts the current query to the resources with all of the specified tags.
+func (builder *ResourceQueryBuilder) WithTag(tags ...string) *ResourceQueryBuilder {
+	for _, tag := range tags {
+		builder.Where(ResourceQueryConditionTag, tag)
+	}
+	return builder
+}
+
+// Select adds fields to the fields selected by the current query.
+func (builder *ResourceQueryBuilder) Select(fields ...ResourceQueryField) *ResourceQueryBuilder {
+	query := &builder.queries[len(builder.queries)-1]
+	for _, field := range fields {
+		query.QuerySelect = append(query.QuerySelect, string(field))
+	}
+	return builder
+}
+
+// Queries returns the queries of the definition after checking them with ValidateResourceQueries.
+func (builder *ResourceQueryBuilder) Queries() ([]ResourceQuery, error) {
+	if err := ValidateResourceQueries(builder.resourceType, builder.queries); err != nil {
+		return nil, err
+	}
+	queries := make([]ResourceQuery, len(builder.queries))
+	copy(queries, builder.queries)
+	return queries, nil
+}
+
+// NewCreateResourceQueryOptions returns the options of CreateResourceQuery for the definition, after checking
+// it. The definition must have a name.
+func (builder *ResourceQueryBuilder) NewCreateResourceQueryOptions() (*CreateResourceQueryOptions, error) {
+	queries, err := builder.checkedQueries()
+	if err != nil {
+		return nil, err
+	}
+	return &CreateResourceQueryOptions{Type: core.StringPtr(builder.resourceType), Name: core.StringPtr(builder.name), Queries: queries}, nil
+}
+
+// NewReplaceResourcesQueryOptions returns the options of ReplaceResourcesQuery that replace the definition of a
+// resource query, after checking it. The definition must have a name.
+func (builder *ResourceQueryBuilder) NewReplaceResourcesQueryOptions(queryID string) (*ReplaceResourcesQueryOptions, error) {
+	queries, err := builder.checkedQueries()
+	if err != nil {
+		return nil, err
+	}
+	return &ReplaceResourcesQueryOptions{QueryID: core.StringPtr(queryID), Type: core.StringPtr(builder.resourceType), Name: core.StringPtr(builder.name), Queries: queries}, nil
+}
+
+func (builder *ResourceQueryBuilder) checkedQueries() ([]ResourceQuery, error) {
+	if strings.TrimSpace(builder.name) == "" {
+		return nil, core.SDKErrorf(nil, "invalid resource query: the definition has no name", "resource-query-validation-error", common.GetComponentInfo())
+	}
+	return builder.Queries()
+}
+
+// ValidateResourceQueries checks the queries of a resource query definition of a resource type, as built by
+// ResourceQueryBuilder or set on CreateResourceQueryOptions and ReplaceResourcesQueryOptions. The resource type must
+// be in ResourceQueryFields. Each query must have a known query type (see ResourceQueryConditions), select a
+// workspace, use only known conditions with non-empty values (without repeating conditions other than tags), and
+// select at least one field that queries of the resource type may select, without repeating fields. All problems
+// are reported in a single error.
+func ValidateResourceQueries(resourceType string, queries []ResourceQuery) error {
+	var problems []string
+	problem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	fields, knownType := ResourceQueryFields[resourceType]
+	if !knownType {
+		problem("unknown resource type '%s'", resourceType)
+	}
+	if len(queries) == 0 {
+		problem("the definition has no queries")
+	}
+	for i, query := range queries {
+		queryType := core.StringNilMapper(query.QueryType)
+		conditions, knownQueryType := ResourceQueryConditions[queryType]
+		if !knownQueryType {
+			problem("query %d: unknown query type '%s'", i+1, queryType)
+		}
+
+		seen := map[string]bool{}
+		for _, param := range query.QueryCondition {
+			name := core.StringNilMapper(param.Name)
+			repeatable, known := conditions[ResourceQueryCondition(name)]
+			switch {
+			case knownQueryType && !known:
+				problem("query %d: unknown condition '%s'", i+1, name)
+			case seen[name] && !repeatable:
+				problem("query %d: condition '%s' is repeated", i+1, name)
+			}
+			if strings.TrimSpace(core.StringNilMapper(param.Value)) == "" {
+				problem("query %d: condition '%s' has no value", i+1, name)
+			}
+			seen[name] = true
+		}
+		if queryType == ResourceQuery_QueryType_Workspaces &&
+			!seen[string(ResourceQueryConditionWorkspaceID)] && !seen[string(ResourceQueryConditionWorkspaceName)] {
+			problem("query %d: no condition selects a workspace (%s or %s)", i+1, ResourceQueryConditionWorkspaceID, ResourceQueryConditionWorkspaceName)
+		}
+
+		if len(query.QuerySelect) == 0 {
+			problem("query %d: no fields are selected", i+1)
+		}
+		selected := map[string]bool{}
+		for _, field := range query.QuerySelect {
+			if knownType && !containsResourceQueryField(fields, field) {
+				problem("query %d: queries of resource type '%s' cannot select '%s'", i+1, resourceType, field)
+			} else if selected[field] {
+				problem("query %d: field '%s' is selected more than once", i+1, field)
+			}
+			selected[field] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid resource query: "+strings.Join(problems, "; "), "resource-query-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func containsResourceQueryField(fields []ResourceQueryField, field string) bool {
+	for _, known := range fields {
+		if string(known) == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 resource query builder`, func() {
+	It(`Build the options of CreateResourceQuery`, func() {
+		options, err := schematicsv1.VSIQuery().
+			Named("web-servers").
+			InWorkspace("ws1").
+			InResourceGroup("default").
+			WithTag("web", "prod").
+			Select(schematicsv1.ResourceQueryFieldIPv4, schematicsv1.ResourceQueryFieldName).
+			Or().
+			InWorkspaceNamed("legacy").
+			Select(schematicsv1.ResourceQueryFieldFloatingIP).
+			NewCreateResourceQueryOptions()
+		Expect(err).To(BeNil())
+		Expect(*options.Type).To(Equal("vsi"))
+		Expect(*options.Name).To(Equal("web-servers"))
+		Expect(options.Queries).To(HaveLen(2))
+
+		query := options.Queries[0]
+		Expect(*query.QueryType).To(Equal(schematicsv1.ResourceQuery_QueryType_Workspaces))
+		var conditions []string
+		for _, param := range query.QueryCondition {
+			conditions = append(conditions, *param.Name+"="+*param.Value)
+		}
+		Expect(conditions).To(Equal([]string{"workspace-id=ws1", "resource-group=default", "tag=web", "tag=prod"}))
+		Expect(query.QuerySelect).To(Equal([]string{"ipv4_address", "name"}))
+		Expect(options.Queries[1].QuerySelect).To(Equal([]string{"floating_ip"}))
+
+		replace, err := schematicsv1.NewResourceQueryBuilder(schematicsv1.ResourceQueryTypeVsi).
+			Named("instances").
+			InWorkspace("ws1").
+			Select(schematicsv1.ResourceQueryFieldID).
+			NewReplaceResourcesQueryOptions("q1")
+		Expect(err).To(BeNil())
+		Expect(*replace.QueryID).To(Equal("q1"))
+		Expect(*replace.Type).To(Equal("vsi"))
+	})
+	It(`Report all problems of a definition`, func() {
+		_, err := schematicsv1.VSIQuery().
+			Named("instances").
+			InResourceGroup("").
+			InRegion("us-south").
+			InRegion("eu-de").
+			Where("owner", "me").
+			Select(schematicsv1.ResourceQueryFieldName, "memory", schematicsv1.ResourceQueryFieldName).
+			Or().
+			InWorkspace("ws1").
+			NewCreateResourceQueryOptions()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid resource query: " +
+			"query 1: condition 'resource-group' has no value; " +
+			"query 1: condition 'region' is repeated; " +
+			"query 1: unknown condition 'owner'; " +
+			"query 1: no condition selects a workspace (workspace-id or workspace-name); " +
+			"query 1: queries of resource type 'vsi' cannot select 'memory'; " +
+			"query 1: field 'name' is selected more than once; " +
+			"query 2: no fields are selected"))
+
+		_, err = schematicsv1.VSIQuery().InWorkspace("ws1").Select(schematicsv1.ResourceQueryFieldName).NewCreateResourceQueryOptions()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid resource query: the definition has no name"))
+
+		queries, err := schematicsv1.VSIQuery().InWorkspace("ws1").Select(schematicsv1.ResourceQueryFieldName).Queries()
+		Expect(err).To(BeNil())
+		Expect(queries).To(HaveLen(1))
+
+		err = schematicsv1.ValidateResourceQueries("bucket", []schematicsv1.ResourceQuery{{QueryType: core.StringPtr("catalog")}})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid resource query: unknown resource type 'bucket'; " +
+			"query 1: unknown query type 'catalog'; query 1: no fields are selected"))
+	})
+	It(`Only accept the resource types of the API unless more are registered`, func() {
+		builder := schematicsv1.NewResourceQueryBuilder("cluster").Named("clusters").InWorkspace("ws1").Select(schematicsv1.ResourceQueryFieldID)
+		_, err := builder.NewCreateResourceQueryOptions()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid resource query: unknown resource type 'cluster'"))
+
+		schematicsv1.ResourceQueryFields["cluster"] = []schematicsv1.ResourceQueryField{schematicsv1.ResourceQueryFieldID}
+		defer delete(schematicsv1.ResourceQueryFields, "cluster")
+		options, err := builder.NewCreateResourceQueryOptions()
+		Expect(err).To(BeNil())
+		Expect(*options.Type).To(Equal("cluster"))
+	})
+	It(`Send the built definition`, func() {
+		var body map[string]interface{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/v2/resources_query"))
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "q1", "name": "web-servers"}`)
+		}))
+		defer testServer.Close()
+		schematicsService, err := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		options, err := schematicsv1.VSIQuery().Named("web-servers").InWorkspace("ws1").WithTag("web").
+			Select(schematicsv1.ResourceQueryFieldIPv4).NewCreateResourceQueryOptions()
+		Expect(err).To(BeNil())
+		result, _, err := schematicsService.CreateResourceQuery(options)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("q1"))
+		Expect(body["type"]).To(Equal("vsi"))
+		Expect(body["queries"]).To(Equal([]interface{}{map[string]interface{}{
+			"query_type": "workspaces",
+			"query_condition": []interface{}{
+				map[string]interface{}{"name": "workspace-id", "value": "ws1"},
+				map[string]interface{}{"name": "tag", "value": "web"},
+			},
+			"query_select": []interface{}{"ipv4_address"},
+		}}))
+	})
+})
@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// resourceQueryPublicAddressNames and resourceQueryPrivateAddressNames are the names of resource query outputs
+// that hold the public and private addresses of a host, in order of preference.
+var (
+	resourceQueryPublicAddressNames  = []string{"floating_ip", "public_ip"}
+	resourceQueryPrivateAddressNames = []string{"primary_ipv4_address", "ipv4_address", "private_ip", "ip_address", "ip"}
+)
+
+// ResourceQueryHostsCSVHeader is the header row of ResourceQueryHosts.WriteCSV.
+var ResourceQueryHostsCSVHeader = []string{"query", "name", "address", "public_ip", "private_ip", "resource_group", "tags"}
+
+// ResourceQueryHost : a resource of the result of a resource query.
+type ResourceQueryHost struct {
+	// The name of the resource query.
+	Query string
+
+	// The name of the resource (the "name" output).
+	Name string
+
+	// The public address of the resource (the first of its floating_ip or public_ip outputs).
+	PublicIP string
+
+	// The private address of the resource (the first of its primary_ipv4_address, ipv4_address, private_ip,
+	// ip_address or ip outputs).
+	PrivateIP string
+
+	// The resource group of the resource (the resource_group_name or resource_group output).
+	ResourceGroup string
+
+	// The tags of the resource (the "tags" output, either a JSON array or a comma-separated list).
+	Tags []string
+
+	// All outputs of the resource, keyed by the last segment of their names.
+	Outputs map[string]string
+}
+
+// Address returns the address to reach the resource at: its public address if it has one, otherwise its private
+// address.
+func (host *ResourceQueryHost) Address() string {
+	if host.PublicIP != "" {
+		return host.PublicIP
+	}
+	return host.PrivateIP
+}
+
+// addressOutput returns the name of the output that holds the address of the resource.
+func (host *ResourceQueryHost) addressOutput() string {
+	for _, name := range resourceQueryAddressNames {
+		if host.Outputs[name] != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// ResourceQueryHosts : the resources of the results of resource queries.
+type ResourceQueryHosts []ResourceQueryHost
+
+// NewResourceQueryHosts : returns the resources of the result of a resource query (see ExecuteResourceQuery).
+//
+// The outputs of a result are a flat list of name/value pairs, where names are paths such as
+// "result.resources.instances.attributes.ipv4_address". Outputs are keyed by the last segment of their names, and
+// an output whose name was already seen starts the outputs of the next resource.
+func NewResourceQueryHosts(queryName string, result *ResourceQueryResponseRecord) (hosts ResourceQueryHosts) {
+	if result == nil {
+		return nil
+	}
+	for _, response := range result.Response {
+		var outputs map[string]string
+		for _, output := range response.QueryOutput {
+			name := core.StringNilMapper(output.Name)
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				name = name[i+1:]
+			}
+			name = inventoryNameInvalidCharacters.ReplaceAllString(name, "_")
+			if _, seen := outputs[name]; outputs == nil || seen {
+				outputs = map[string]string{}
+				hosts = append(hosts, ResourceQueryHost{Query: queryName, Outputs: outputs})
+			}
+			outputs[name] = core.StringNilMapper(output.Value)
+		}
+	}
+	for i := range hosts {
+		host := &hosts[i]
+		host.Name = host.Outputs["name"]
+		host.PublicIP = firstResourceQueryOutput(host.Outputs, resourceQueryPublicAddressNames)
+		host.PrivateIP = firstResourceQueryOutput(host.Outputs, resourceQueryPrivateAddressNames)
+		host.ResourceGroup = firstResourceQueryOutput(host.Outputs, []string{"resource_group_name", "resource_group"})
+		host.Tags = resourceQueryTags(host.Outputs["tags"])
+	}
+	return hosts
+}
+
+// ExecuteResourceQueryHosts runs a resource query and returns the resources of its result, whose Query is the name
+// of the query (or its ID if it has no name).
+func (schematics *SchematicsV1) ExecuteResourceQueryHosts(ctx context.Context, queryID string) (ResourceQueryHosts, error) {
+	result, err := schematics.executeResourceQuery(ctx, queryID)
+	if err != nil {
+		return nil, err
+	}
+	return result.hosts, nil
+}
+
+// namedResourceQueryHosts : the name of a resource query and the resources of its result.
+type namedResourceQueryHosts struct {
+	name  string
+	hosts ResourceQueryHosts
+}
+
+func (schematics *SchematicsV1) executeResourceQuery(ctx context.Context, queryID string) (*namedResourceQueryHosts, error) {
+	query, _, err := schematics.GetResourcesQueryWithContext(ctx, schematics.NewGetResourcesQueryOptions(queryID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-resource-query-error")
+	}
+	result, _, err := schematics.ExecuteResourceQueryWithContext(ctx, schematics.NewExecuteResourceQueryOptions(queryID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "execute-resource-query-error")
+	}
+	name := core.StringNilMapper(query.Name)
+	if name == "" {
+		name = queryID
+	}
+	return &namedResourceQueryHosts{name, NewResourceQueryHosts(name, result)}, nil
+}
+
+// Inventory returns an inventory of the resources that have an address. Each resource is a host named by its
+// address, with its other outputs as variables, in a group named after its query (see AddResourceQuery).
+func (hosts ResourceQueryHosts) Inventory() *Inventory {
+	inventory := &Inventory{}
+	for _, host := range hosts {
+		addressOutput := host.addressOutput()
+		if addressOutput == "" {
+			continue
+		}
+		inventoryHost := inventory.AddGroup(inventoryGroupName(host.Query)).AddHost(host.Outputs[addressOutput])
+		for _, name := range sortedKeys(host.Outputs) {
+			if name != addressOutput {
+				inventoryHost.SetVar(name, host.Outputs[name])
+			}
+		}
+	}
+	return inventory
+}
+
+// WriteCSV writes the resources as CSV, with a ResourceQueryHostsCSVHeader row and a row per resource. Tags are
+// joined with commas.
+func (hosts ResourceQueryHosts) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(ResourceQueryHostsCSVHeader); err != nil {
+		return core.SDKErrorf(err, "", "csv-write-error", common.GetComponentInfo())
+	}
+	for _, host := range hosts {
+		err := csvWriter.Write([]string{host.Query, host.Name, host.Address(), host.PublicIP, host.PrivateIP, host.ResourceGroup, strings.Join(host.Tags, ",")})
+		if err != nil {
+			return core.SDKErrorf(err, "", "csv-write-error", common.GetComponentInfo())
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return core.SDKErrorf(err, "", "csv-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func firstResourceQueryOutput(outputs map[string]string, names []string) string {
+	for _, name := range names {
+		if outputs[name] != "" {
+			return outputs[name]
+		}
+	}
+	return ""
+}
+
+// resourceQueryTags returns the tags of a "tags" output, which is either a JSON array or a comma-separated list.
+func resourceQueryTags(value string) (tags []string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if strings.HasPrefix(value, "[") && json.Unmarshal([]byte(value), &tags) == nil {
+		return tags
+	}
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 resource query hosts`, func() {
+	const queryResult = `{"response": [{"query_type": "workspaces", "query_output": [
+		{"name": "result.resources.instances.attributes.name", "value": "vsi-1"},
+		{"name": "result.resources.instances.attributes.ipv4_address", "value": "10.0.0.1"},
+		{"name": "result.resources.instances.attributes.floating_ip", "value": "169.0.0.1"},
+		{"name": "result.resources.instances.attributes.resource_group", "value": "rg-1"},
+		{"name": "result.resources.instances.attributes.tags", "value": "[\"web\", \"prod\"]"},
+		{"name": "result.resources.instances.attributes.name", "value": "vsi-2"},
+		{"name": "result.resources.instances.attributes.primary_ipv4_address", "value": "10.0.0.2"},
+		{"name": "result.resources.instances.attributes.resource_group_name", "value": "default"},
+		{"name": "result.resources.instances.attributes.tags", "value": "web, staging"},
+		{"name": "result.resources.instances.attributes.name", "value": "no-address"}
+	]}]}`
+
+	var result *schematicsv1.ResourceQueryResponseRecord
+	BeforeEach(func() {
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(queryResult), &raw)).To(Succeed())
+		Expect(core.UnmarshalModel(raw, "", &result, schematicsv1.UnmarshalResourceQueryResponseRecord)).To(Succeed())
+	})
+
+	It(`Flatten a resource query result`, func() {
+		hosts := schematicsv1.NewResourceQueryHosts("web servers", result)
+		Expect(hosts).To(HaveLen(3))
+		Expect(hosts[0]).To(Equal(schematicsv1.ResourceQueryHost{
+			Query:         "web servers",
+			Name:          "vsi-1",
+			PublicIP:      "169.0.0.1",
+			PrivateIP:     "10.0.0.1",
+			ResourceGroup: "rg-1",
+			Tags:          []string{"web", "prod"},
+			Outputs: map[string]string{
+				"name":           "vsi-1",
+				"ipv4_address":   "10.0.0.1",
+				"floating_ip":    "169.0.0.1",
+				"resource_group": "rg-1",
+				"tags":           `["web", "prod"]`,
+			},
+		}))
+		Expect(hosts[0].Address()).To(Equal("169.0.0.1"))
+		Expect(hosts[1].Address()).To(Equal("10.0.0.2"))
+		Expect(hosts[1].ResourceGroup).To(Equal("default"))
+		Expect(hosts[1].Tags).To(Equal([]string{"web", "staging"}))
+		Expect(hosts[2].Name).To(Equal("no-address"))
+		Expect(hosts[2].Address()).To(BeEmpty())
+
+		Expect(schematicsv1.NewResourceQueryHosts("empty", nil)).To(BeEmpty())
+	})
+	It(`Start a new resource at a repeated output with an empty value`, func() {
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(`{"response": [{"query_output": [
+			{"name": "result.resources.instances.attributes.name", "value": ""},
+			{"name": "result.resources.instances.attributes.ipv4_address", "value": "10.0.0.1"},
+			{"name": "result.resources.instances.attributes.name", "value": "vsi-2"},
+			{"name": "result.resources.instances.attributes.ipv4_address", "value": "10.0.0.2"}
+		]}]}`), &raw)).To(Succeed())
+		Expect(core.UnmarshalModel(raw, "", &result, schematicsv1.UnmarshalResourceQueryResponseRecord)).To(Succeed())
+
+		hosts := schematicsv1.NewResourceQueryHosts("web", result)
+		Expect(hosts).To(HaveLen(2))
+		Expect(hosts[0].Name).To(BeEmpty())
+		Expect(hosts[0].PrivateIP).To(Equal("10.0.0.1"))
+		Expect(hosts[1].Name).To(Equal("vsi-2"))
+		Expect(hosts[1].PrivateIP).To(Equal("10.0.0.2"))
+	})
+	It(`Format resource query hosts as an INI inventory`, func() {
+		inventory := schematicsv1.NewResourceQueryHosts("web servers", result).Inventory()
+		Expect(inventory.String()).To(Equal(`[web_servers]
+169.0.0.1 ipv4_address=10.0.0.1 name=vsi-1 resource_group=rg-1 tags="[\"web\", \"prod\"]"
+10.0.0.2 name=vsi-2 resource_group_name=default tags="web, staging"
+`))
+		Expect(inventory.Validate()).To(Succeed())
+	})
+	It(`Write resource query hosts as CSV`, func() {
+		var buffer bytes.Buffer
+		Expect(schematicsv1.NewResourceQueryHosts("web", result).WriteCSV(&buffer)).To(Succeed())
+		Expect(buffer.String()).To(Equal(`query,name,address,public_ip,private_ip,resource_group,tags
+web,vsi-1,169.0.0.1,169.0.0.1,10.0.0.1,rg-1,"web,prod"
+web,vsi-2,10.0.0.2,,10.0.0.2,default,"web,staging"
+web,no-address,,,,,
+`))
+	})
+	It(`Execute a resource query`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/v2/resources_query/q1"))
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == http.MethodGet {
+				fmt.Fprint(res, `{"id": "q1"}`)
+			} else {
+				fmt.Fprint(res, queryResult)
+			}
+		}))
+		defer testServer.Close()
+		schematicsService, err := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		hosts, err := schematicsService.ExecuteResourceQueryHosts(context.Background(), "q1")
+		Expect(err).To(BeNil())
+		Expect(hosts).To(HaveLen(3))
+		Expect(hosts[1].Query).To(Equal("q1"))
+	})
+})
@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeStore is an in-memory implementation of the list, create, get, update and delete operations of
+// Schematics resource collections, keyed by collection path (e.g. "/v1/workspaces").
+type fakeStore struct {
+	mutex       sync.Mutex
+	collections map[string]*fakeCollection
+	mutations   []string
+}
+
+type fakeCollection struct {
+	listKey  string
+	countKey string
+	items    []map[string]interface{}
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{collections: map[string]*fakeCollection{}}
+}
+
+func (store *fakeStore) addCollection(path string, listKey string, countKey string) {
+	store.collections[path] = &fakeCollection{listKey: listKey, countKey: countKey}
+}
+
+// add adds an item to a collection, assigning an ID if it has none, and returns it.
+func (store *fakeStore) add(path string, item map[string]interface{}) map[string]interface{} {
+	collection := store.collections[path]
+	if _, ok := item["id"]; !ok {
+		item["id"] = fmt.Sprintf("us-south.%s.%d", collection.listKey, len(collection.items))
+	}
+	collection.items = append(collection.items, item)
+	return item
+}
+
+func (store *fakeStore) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	defer GinkgoRecover()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	res.Header().Set("Content-type", "application/json")
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	path := "/" + strings.Join(segments[:2], "/")
+	collection, ok := store.collections[path]
+	if !ok {
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"error": "not found"}`)
+		return
+	}
+
+	if len(segments) == 2 {
+		if req.Method == "GET" {
+			offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			end := min(offset+limit, len(collection.items))
+			body, _ := json.Marshal(map[string]interface{}{collection.listKey: collection.items[offset:end], collection.countKey: len(collection.items), "offset": offset, "limit": limit})
+			res.WriteHeader(200)
+			res.Write(body)
+			return
+		}
+		var item map[string]interface{}
+		Expect(json.NewDecoder(req.Body).Decode(&item)).To(Succeed())
+		store.add(path, item)
+		store.mutations = append(store.mutations, "POST")
+		body, _ := json.Marshal(item)
+		res.WriteHeader(201)
+		res.Write(body)
+		return
+	}
+
+	for i, item := range collection.items {
+		if item["id"] != segments[2] {
+			continue
+		}
+		switch req.Method {
+		case "DELETE":
+			collection.items = append(collection.items[:i], collection.items[i+1:]...)
+			store.mutations = append(store.mutations, "DELETE "+segments[2])
+			res.WriteHeader(204)
+			return
+		case "PATCH", "PUT":
+			var changes map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&changes)).To(Succeed())
+			keys := []string{}
+			for key, value := range changes {
+				item[key] = value
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			store.mutations = append(store.mutations, req.Method+" "+strings.Join(keys, ","))
+		}
+		body, _ := json.Marshal(item)
+		res.WriteHeader(200)
+		res.Write(body)
+		return
+	}
+	res.WriteHeader(404)
+	fmt.Fprint(res, `{"error": "not found"}`)
+}
+
+// slowResource is a Resource whose deletions take a while, to observe how many run at once.
+type slowResource struct {
+	schematicsv1.Resource[schematicsv1.Action]
+	actions  []schematicsv1.Action
+	running  int32
+	maxCalls int32
+	deletes  int32
+
+	// cancel, if set, is called by every deletion.
+	cancel context.CancelFunc
+}
+
+func (resource *slowResource) List(ctx context.Context) ([]schematicsv1.Action, error) {
+	return resource.actions, nil
+}
+
+func (resource *slowResource) Summary(action *schematicsv1.Action) schematicsv1.ResourceSummary {
+	return schematicsv1.ResourceSummary{ID: *action.ID}
+}
+
+func (resource *slowResource) Delete(ctx context.Context, id string) error {
+	atomic.AddInt32(&resource.deletes, 1)
+	if resource.cancel != nil {
+		resource.cancel()
+	}
+	running := atomic.AddInt32(&resource.running, 1)
+	defer atomic.AddInt32(&resource.running, -1)
+	for {
+		maxCalls := atomic.LoadInt32(&resource.maxCalls)
+		if running <= maxCalls || atomic.CompareAndSwapInt32(&resource.maxCalls, maxCalls, running) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return nil
+}
+
+var _ = Describe(`SchematicsV1 resources`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var store *fakeStore
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		store = newFakeStore()
+		store.addCollection("/v1/workspaces", "workspaces", "count")
+		store.addCollection("/v2/actions", "actions", "total_count")
+		store.addCollection("/v2/inventories", "inventories", "total_count")
+		testServer = httptest.NewServer(store)
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		for i := 0; i < 3; i++ {
+			store.add("/v1/workspaces", map[string]interface{}{
+				"name": fmt.Sprintf("ws%d", i), "resource_group": "default", "location": "us-south", "tags": []string{"team:a"},
+			})
+			store.add("/v2/actions", map[string]interface{}{
+				"name": fmt.Sprintf("act%d", i), "location": "eu-de", "tags": []string{fmt.Sprintf("n:%d", i)}, "inventory": "inv",
+			})
+		}
+		store.collections["/v1/workspaces"].items[2]["tags"] = []string{"team:b"}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`List and select resources of any kind`, func() {
+		workspaces := schematicsService.Workspaces(nil)
+		Expect(workspaces.Kind()).To(Equal(schematicsv1.ResourceKindWorkspace))
+
+		selected, err := schematicsv1.SelectResources(ctx, workspaces, schematicsv1.SelectByTag("team:a").And(schematicsv1.SelectByLocation("us-south")))
+		Expect(err).To(BeNil())
+		Expect(selected).To(HaveLen(2))
+		Expect(workspaces.Summary(&selected[1])).To(Equal(schematicsv1.ResourceSummary{
+			ID: "us-south.workspaces.1", Name: "ws1", ResourceGroup: "default", Location: "us-south", Tags: []string{"team:a"},
+		}))
+
+		actions, err := schematicsService.Actions(nil).List(ctx)
+		Expect(err).To(BeNil())
+		Expect(actions).To(HaveLen(3))
+		Expect(*actions[0].Name).To(Equal("act0"))
+		Expect(*actions[0].Location).To(Equal("eu-de"))
+	})
+	It(`Create and update resources through their options`, func() {
+		workspaces := schematicsService.Workspaces(nil)
+		created, err := workspaces.Create(ctx, &schematicsv1.WorkspaceResponse{
+			Name: core.StringPtr("new"),
+			Tags: []string{"x"},
+			ID:   core.StringPtr("ignored"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*created.ID).To(Equal("us-south.workspaces.3"))
+
+		updated, err := workspaces.Update(ctx, *created.ID, &schematicsv1.WorkspaceResponse{Description: core.StringPtr("changed")})
+		Expect(err).To(BeNil())
+		Expect(*updated.Description).To(Equal("changed"))
+		Expect(store.mutations).To(Equal([]string{"POST", "PATCH description"}))
+	})
+	It(`Leave secure values and credentials out of updates`, func() {
+		store.add("/v2/actions", map[string]interface{}{"id": "secret-action", "name": "act"})
+		actions := schematicsService.Actions(nil)
+		_, err := actions.Update(ctx, "secret-action", &schematicsv1.Action{
+			Description: core.StringPtr("changed"),
+			Inputs: []schematicsv1.VariableData{
+				{Name: core.StringPtr("region"), Value: core.StringPtr("us-south")},
+				{Name: core.StringPtr("api_key"), Value: core.StringPtr("****"), Metadata: &schematicsv1.VariableMetadata{Secure: core.BoolPtr(true)}},
+			},
+			Credentials:       []schematicsv1.CredentialVariableData{{Name: core.StringPtr("ssh_key"), Value: core.StringPtr("****")}},
+			BastionCredential: &schematicsv1.CredentialVariableData{Name: core.StringPtr("ssh_key"), Value: core.StringPtr("****")},
+		})
+		Expect(err).To(BeNil())
+		Expect(store.mutations).To(Equal([]string{"PATCH description"}))
+		Expect(store.collections["/v2/actions"].items[3]).ToNot(HaveKey("inputs"))
+
+		_, err = schematicsService.Workspaces(nil).Update(ctx, "us-south.workspaces.0", &schematicsv1.WorkspaceResponse{
+			Description: core.StringPtr("changed"),
+			TemplateData: []schematicsv1.TemplateSourceDataResponse{{
+				EnvValues: []schematicsv1.EnvVariableResponse{
+					{Name: core.StringPtr("TF_LOG"), Value: core.StringPtr("debug")},
+					{Name: core.StringPtr("IC_API_KEY"), Value: core.StringPtr("****"), Secure: core.BoolPtr(true)},
+				},
+				Values:        core.StringPtr("region = \"us-south\""),
+				Variablestore: []schematicsv1.WorkspaceVariableResponse{{Name: core.StringPtr("token"), Value: core.StringPtr("****"), Secure: core.BoolPtr(true)}},
+			}},
+			WorkspaceStatus:    &schematicsv1.WorkspaceStatusResponse{Frozen: core.BoolPtr(true)},
+			WorkspaceStatusMsg: &schematicsv1.WorkspaceStatusMessage{StatusMsg: core.StringPtr("frozen")},
+		})
+		Expect(err).To(BeNil())
+		Expect(store.mutations[1]).To(Equal("PATCH description,template_data"))
+		body, _ := json.Marshal(store.collections["/v1/workspaces"].items[0]["template_data"])
+		Expect(string(body)).To(Equal(`[{"values":"region = \"us-south\""}]`))
+	})
+	It(`Tag resources in bulk`, func() {
+		tagged, err := schematicsv1.TagResources(ctx, schematicsService.Workspaces(nil), nil, []string{"owner:me"}, []string{"team:a"})
+		Expect(err).To(BeNil())
+		Expect(tagged).To(Equal([]string{"us-south.workspaces.0", "us-south.workspaces.1", "us-south.workspaces.2"}))
+		Expect(store.collections["/v1/workspaces"].items[0]["tags"]).To(Equal([]interface{}{"owner:me"}))
+		Expect(store.collections["/v1/workspaces"].items[2]["tags"]).To(Equal([]interface{}{"team:b", "owner:me"}))
+
+		tagged, err = schematicsv1.TagResources(ctx, schematicsService.Workspaces(nil), nil, []string{"owner:me"}, nil)
+		Expect(err).To(BeNil())
+		Expect(tagged).To(BeEmpty())
+	})
+	It(`Reject tags for kinds without tags`, func() {
+		store.add("/v2/inventories", map[string]interface{}{"name": "hosts", "inventories_ini": "[web]"})
+		err := schematicsService.Inventories(nil).SetTags(ctx, "us-south.inventories.0", []string{"x"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("have no tags"))
+		Expect(store.mutations).To(BeEmpty())
+	})
+	It(`Delete resources by selector`, func() {
+		actions := schematicsService.Actions(&schematicsv1.ResourceOptions{Force: true})
+		_, err := schematicsv1.DeleteResources(ctx, actions, nil)
+		Expect(err).ToNot(BeNil())
+
+		deleted, err := schematicsv1.DeleteResources(ctx, actions, schematicsv1.SelectByName("act0", "act2"))
+		Expect(err).To(BeNil())
+		Expect(deleted).To(Equal([]string{"us-south.actions.0", "us-south.actions.2"}))
+		Expect(store.collections["/v2/actions"].items).To(HaveLen(1))
+
+		_, err = schematicsv1.DeleteResources(ctx, schematicsService.Workspaces(nil), schematicsv1.SelectByName("ws0"))
+		Expect(err).ToNot(BeNil())
+		resourceErrors, ok := err.(schematicsv1.ResourceErrors)
+		Expect(ok).To(BeTrue())
+		Expect(resourceErrors).To(HaveKey("us-south.workspaces.0"))
+	})
+	It(`Delete a bounded number of resources at once`, func() {
+		resource := &slowResource{}
+		for i := 0; i < 30; i++ {
+			resource.actions = append(resource.actions, schematicsv1.Action{ID: core.StringPtr(fmt.Sprintf("act%02d", i))})
+		}
+		deleted, err := schematicsv1.DeleteResources(ctx, schematicsv1.Resource[schematicsv1.Action](resource), func(schematicsv1.ResourceSummary) bool { return true })
+		Expect(err).To(BeNil())
+		Expect(deleted).To(HaveLen(30))
+		Expect(resource.maxCalls).To(BeNumerically("<=", 8))
+		Expect(resource.maxCalls).To(BeNumerically(">", 1))
+	})
+	It(`Stop starting deletions when the context is done`, func() {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		resource := &slowResource{cancel: cancel}
+		for i := 0; i < 30; i++ {
+			resource.actions = append(resource.actions, schematicsv1.Action{ID: core.StringPtr(fmt.Sprintf("act%02d", i))})
+		}
+		deleted, err := schematicsv1.DeleteResources(cancelCtx, schematicsv1.Resource[schematicsv1.Action](resource), func(schematicsv1.ResourceSummary) bool { return true })
+		Expect(err).ToNot(BeNil())
+		Expect(atomic.LoadInt32(&resource.deletes)).To(BeNumerically("<=", 8))
+		Expect(deleted).To(HaveLen(int(resource.deletes)))
+		errs, ok := err.(schematicsv1.ResourceErrors)
+		Expect(ok).To(BeTrue())
+		Expect(errs).To(HaveLen(30 - len(deleted)))
+		Expect(errs["act29"]).To(MatchError(context.Canceled))
+	})
+	It(`Export the full definitions of selected resources`, func() {
+		var buf bytes.Buffer
+		err := schematicsv1.ExportResources(ctx, schematicsService.Actions(nil), schematicsv1.SelectByTag("n:1"), &buf)
+		Expect(err).To(BeNil())
+
+		var export struct {
+			Kind      string                `json:"kind"`
+			Resources []schematicsv1.Action `json:"resources"`
+		}
+		Expect(json.Unmarshal(buf.Bytes(), &export)).To(Succeed())
+		Expect(export.Kind).To(Equal("action"))
+		Expect(export.Resources).To(HaveLen(1))
+		Expect(*export.Resources[0].Inventory).To(Equal("inv"))
+	})
+})
@@ -0,0 +1,683 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// Router : dispatches Schematics operations to the regional endpoint that owns a resource.
+//
+// Schematics resource IDs are prefixed with the location that holds them (e.g. "us-south.workspace.name.1a2b3c4d"),
+// so operations on an existing resource are sent to the client of that location. Create operations are sent to the
+// client of the Location in their options, and List operations are sent to every region and merged. The Router
+// routes the operations on workspaces, actions, jobs, inventories, resource queries, agents and policies; send other
+// operations with the client returned by Client or ClientForID.
+// A Router is safe for concurrent use.
+type Router struct {
+	service      *SchematicsV1
+	endpointType string
+
+	mutex   sync.RWMutex
+	clients map[string]*SchematicsV1
+}
+
+// RegionErrors : the errors returned by individual regions during a fan-out operation, keyed by region.
+type RegionErrors map[string]error
+
+// Error returns a summary of the regional errors.
+func (errs RegionErrors) Error() string {
+	return summarizeErrors("region", errs)
+}
+
+// NewRouter : constructs a Router whose regional clients are clones of service, bound to the endpoints of the
+// specified type (EndpointTypePublic if empty) in each of the specified regions.
+// Further regions can be added with AddRegion or DiscoverRegions.
+func NewRouter(service *SchematicsV1, endpointType string, regions ...string) (router *Router, err error) {
+	if core.IsNil(service) {
+		err = core.SDKErrorf(nil, "service cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if endpointType == "" {
+		endpointType = EndpointTypePublic
+	}
+
+	router = &Router{
+		service:      service,
+		endpointType: endpointType,
+		clients:      make(map[string]*SchematicsV1),
+	}
+	for _, region := range regions {
+		var url string
+		url, err = GetServiceURLForRegionEndpoint(region, endpointType)
+		if err != nil {
+			router = nil
+			err = core.RepurposeSDKProblem(err, "router-region-error")
+			return
+		}
+		err = router.AddRegion(region, url)
+		if err != nil {
+			router = nil
+			return
+		}
+	}
+	return
+}
+
+// AddRegion adds (or replaces) the client used for a region, bound to the specified service URL.
+func (router *Router) AddRegion(region string, serviceURL string) error {
+	client := router.service.Clone()
+	err := client.SetServiceURL(serviceURL)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "router-url-error")
+	}
+
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+	router.clients[region] = client
+	return nil
+}
+
+// DiscoverRegions adds a client for every location returned by ListLocations, using the endpoint advertised by
+// the service or, if none is advertised, the endpoint from the regional endpoint table.
+// Locations without a usable endpoint are skipped.
+func (router *Router) DiscoverRegions(ctx context.Context) error {
+	locations, _, err := router.service.ListLocationsWithContext(ctx, router.service.NewListLocationsOptions())
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "router-discovery-error")
+	}
+
+	for _, location := range locations.Locations {
+		if location.Region == nil {
+			continue
+		}
+		var url string
+		switch router.endpointType {
+		case EndpointTypePublic:
+			url = core.StringNilMapper(location.SchematicsRegionalPublicEndpoint)
+		case EndpointTypePrivate:
+			url = core.StringNilMapper(location.SchematicsRegionalPrivateEndpoint)
+		}
+		if url == "" {
+			url, _ = GetServiceURLForRegionEndpoint(*location.Region, router.endpointType)
+		}
+		if url == "" {
+			continue
+		}
+		err = router.AddRegion(*location.Region, url)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Regions returns the regions known to the router, in sorted order.
+func (router *Router) Regions() []string {
+	router.mutex.RLock()
+	defer router.mutex.RUnlock()
+	return sortedKeys(router.clients)
+}
+
+// Client returns the client used for a region.
+func (router *Router) Client(region string) (*SchematicsV1, error) {
+	router.mutex.RLock()
+	client, ok := router.clients[region]
+	router.mutex.RUnlock()
+	if !ok {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("no client is configured for region '%s'", region), "router-unknown-region", common.GetComponentInfo())
+	}
+	return client, nil
+}
+
+// ClientForID returns the client of the region encoded in a resource ID.
+func (router *Router) ClientForID(id string) (*SchematicsV1, error) {
+	region, ok := RegionFromID(id)
+	if !ok {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("resource ID '%s' does not encode a region", id), "router-invalid-id", common.GetComponentInfo())
+	}
+	return router.Client(region)
+}
+
+// RegionFromID returns the region encoded in a Schematics resource ID such as "us-south.workspace.name.1a2b3c4d".
+func RegionFromID(id string) (region string, ok bool) {
+	region, _, ok = strings.Cut(id, ".")
+	return region, ok && region != ""
+}
+
+// clientForID returns the client for an optional resource ID taken from an options struct.
+// A nil ID is routed to the template service so that its validation reports the error.
+func (router *Router) clientForID(id *string) (*SchematicsV1, error) {
+	if id == nil {
+		return router.service, nil
+	}
+	return router.ClientForID(*id)
+}
+
+// clientForLocation returns the client for an optional location taken from an options struct.
+func (router *Router) clientForLocation(location *string) (*SchematicsV1, error) {
+	if location == nil {
+		return nil, core.SDKErrorf(nil, "a location is required to route the operation", "router-missing-location", common.GetComponentInfo())
+	}
+	return router.Client(*location)
+}
+
+// fanOut invokes call concurrently with the client of every region and returns the successful results by region.
+// If any region fails, the returned error is a RegionErrors.
+func fanOut[T any](ctx context.Context, router *Router, call func(ctx context.Context, client *SchematicsV1) (T, *core.DetailedResponse, error)) (map[string]T, error) {
+	router.mutex.RLock()
+	clients := make(map[string]*SchematicsV1, len(router.clients))
+	for region, client := range router.clients {
+		clients[region] = client
+	}
+	router.mutex.RUnlock()
+
+	results, errs := concurrently(ctx, sortedKeys(clients), func(ctx context.Context, region string) (T, error) {
+		result, _, err := call(ctx, clients[region])
+		return result, err
+	})
+	if len(errs) > 0 {
+		return results, RegionErrors(errs)
+	}
+	return results, nil
+}
+
+// GetWorkspaceWithContext gets a workspace from the region encoded in its ID.
+func (router *Router) GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getWorkspaceOptions, func(o *GetWorkspaceOptions) *string { return o.WID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetWorkspaceWithContext(ctx, getWorkspaceOptions)
+}
+
+// UpdateWorkspaceWithContext updates a workspace in the region encoded in its ID.
+func (router *Router) UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(updateWorkspaceOptions, func(o *UpdateWorkspaceOptions) *string { return o.WID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdateWorkspaceWithContext(ctx, updateWorkspaceOptions)
+}
+
+// ReplaceWorkspaceWithContext replaces a workspace in the region encoded in its ID.
+func (router *Router) ReplaceWorkspaceWithContext(ctx context.Context, replaceWorkspaceOptions *ReplaceWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(replaceWorkspaceOptions, func(o *ReplaceWorkspaceOptions) *string { return o.WID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.ReplaceWorkspaceWithContext(ctx, replaceWorkspaceOptions)
+}
+
+// DeleteWorkspaceWithContext deletes a workspace in the region encoded in its ID.
+func (router *Router) DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (*string, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteWorkspaceOptions, func(o *DeleteWorkspaceOptions) *string { return o.WID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.DeleteWorkspaceWithContext(ctx, deleteWorkspaceOptions)
+}
+
+// CreateWorkspaceWithContext creates a workspace in the region named by its Location.
+func (router *Router) CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (*WorkspaceResponse, *core.DetailedResponse, error) {
+	client, err := router.clientForLocation(optionsField(createWorkspaceOptions, func(o *CreateWorkspaceOptions) *string { return o.Location }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateWorkspaceWithContext(ctx, createWorkspaceOptions)
+}
+
+// ListWorkspacesWithContext lists the workspaces of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the workspaces of the other regions are returned together with a RegionErrors.
+func (router *Router) ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (*WorkspaceResponseList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*WorkspaceResponseList, *core.DetailedResponse, error) {
+		return client.ListWorkspacesWithContext(ctx, listWorkspacesOptions)
+	})
+
+	merged := &WorkspaceResponseList{Count: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Workspaces = append(merged.Workspaces, result.Workspaces...)
+		*merged.Count += int64Value(result.Count)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listWorkspacesOptions, func(o *ListWorkspacesOptions) *int64 { return o.Offset }),
+		optionsField(listWorkspacesOptions, func(o *ListWorkspacesOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetActionWithContext gets an action from the region encoded in its ID.
+func (router *Router) GetActionWithContext(ctx context.Context, getActionOptions *GetActionOptions) (*Action, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getActionOptions, func(o *GetActionOptions) *string { return o.ActionID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetActionWithContext(ctx, getActionOptions)
+}
+
+// UpdateActionWithContext updates an action in the region encoded in its ID.
+func (router *Router) UpdateActionWithContext(ctx context.Context, updateActionOptions *UpdateActionOptions) (*Action, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(updateActionOptions, func(o *UpdateActionOptions) *string { return o.ActionID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdateActionWithContext(ctx, updateActionOptions)
+}
+
+// DeleteActionWithContext deletes an action in the region encoded in its ID.
+func (router *Router) DeleteActionWithContext(ctx context.Context, deleteActionOptions *DeleteActionOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteActionOptions, func(o *DeleteActionOptions) *string { return o.ActionID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteActionWithContext(ctx, deleteActionOptions)
+}
+
+// CreateActionWithContext creates an action in the region named by its Location.
+func (router *Router) CreateActionWithContext(ctx context.Context, createActionOptions *CreateActionOptions) (*Action, *core.DetailedResponse, error) {
+	client, err := router.clientForLocation(optionsField(createActionOptions, func(o *CreateActionOptions) *string { return o.Location }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateActionWithContext(ctx, createActionOptions)
+}
+
+// ListActionsWithContext lists the actions of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the actions of the other regions are returned together with a RegionErrors.
+func (router *Router) ListActionsWithContext(ctx context.Context, listActionsOptions *ListActionsOptions) (*ActionList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*ActionList, *core.DetailedResponse, error) {
+		return client.ListActionsWithContext(ctx, listActionsOptions)
+	})
+
+	merged := &ActionList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Actions = append(merged.Actions, result.Actions...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listActionsOptions, func(o *ListActionsOptions) *int64 { return o.Offset }),
+		optionsField(listActionsOptions, func(o *ListActionsOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetJobWithContext gets a job from the region encoded in its ID.
+func (router *Router) GetJobWithContext(ctx context.Context, getJobOptions *GetJobOptions) (*Job, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getJobOptions, func(o *GetJobOptions) *string { return o.JobID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetJobWithContext(ctx, getJobOptions)
+}
+
+// UpdateJobWithContext updates a job in the region encoded in its ID.
+func (router *Router) UpdateJobWithContext(ctx context.Context, updateJobOptions *UpdateJobOptions) (*Job, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(updateJobOptions, func(o *UpdateJobOptions) *string { return o.JobID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdateJobWithContext(ctx, updateJobOptions)
+}
+
+// DeleteJobWithContext deletes a job in the region encoded in its ID.
+func (router *Router) DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteJobOptions, func(o *DeleteJobOptions) *string { return o.JobID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteJobWithContext(ctx, deleteJobOptions)
+}
+
+// CreateJobWithContext creates a job in the region named by its Location or, if it has none,
+// in the region encoded in its CommandObjectID.
+func (router *Router) CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (*Job, *core.DetailedResponse, error) {
+	var client *SchematicsV1
+	var err error
+	if createJobOptions != nil && createJobOptions.Location == nil && createJobOptions.CommandObjectID != nil {
+		client, err = router.ClientForID(*createJobOptions.CommandObjectID)
+	} else {
+		client, err = router.clientForLocation(optionsField(createJobOptions, func(o *CreateJobOptions) *string { return o.Location }))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateJobWithContext(ctx, createJobOptions)
+}
+
+// ListJobsWithContext lists the jobs of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the jobs of the other regions are returned together with a RegionErrors.
+func (router *Router) ListJobsWithContext(ctx context.Context, listJobsOptions *ListJobsOptions) (*JobList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*JobList, *core.DetailedResponse, error) {
+		return client.ListJobsWithContext(ctx, listJobsOptions)
+	})
+
+	merged := &JobList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Jobs = append(merged.Jobs, result.Jobs...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listJobsOptions, func(o *ListJobsOptions) *int64 { return o.Offset }),
+		optionsField(listJobsOptions, func(o *ListJobsOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetInventoryWithContext gets an inventory from the region encoded in its ID.
+func (router *Router) GetInventoryWithContext(ctx context.Context, getInventoryOptions *GetInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getInventoryOptions, func(o *GetInventoryOptions) *string { return o.InventoryID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetInventoryWithContext(ctx, getInventoryOptions)
+}
+
+// ReplaceInventoryWithContext replaces an inventory in the region encoded in its ID.
+func (router *Router) ReplaceInventoryWithContext(ctx context.Context, replaceInventoryOptions *ReplaceInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(replaceInventoryOptions, func(o *ReplaceInventoryOptions) *string { return o.InventoryID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.ReplaceInventoryWithContext(ctx, replaceInventoryOptions)
+}
+
+// DeleteInventoryWithContext deletes an inventory in the region encoded in its ID.
+func (router *Router) DeleteInventoryWithContext(ctx context.Context, deleteInventoryOptions *DeleteInventoryOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteInventoryOptions, func(o *DeleteInventoryOptions) *string { return o.InventoryID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteInventoryWithContext(ctx, deleteInventoryOptions)
+}
+
+// CreateInventoryWithContext creates an inventory in the region named by its Location.
+func (router *Router) CreateInventoryWithContext(ctx context.Context, createInventoryOptions *CreateInventoryOptions) (*InventoryResourceRecord, *core.DetailedResponse, error) {
+	client, err := router.clientForLocation(optionsField(createInventoryOptions, func(o *CreateInventoryOptions) *string { return o.Location }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateInventoryWithContext(ctx, createInventoryOptions)
+}
+
+// ListInventoriesWithContext lists the inventories of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the inventories of the other regions are returned together with a RegionErrors.
+func (router *Router) ListInventoriesWithContext(ctx context.Context, listInventoriesOptions *ListInventoriesOptions) (*InventoryResourceRecordList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*InventoryResourceRecordList, *core.DetailedResponse, error) {
+		return client.ListInventoriesWithContext(ctx, listInventoriesOptions)
+	})
+
+	merged := &InventoryResourceRecordList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Inventories = append(merged.Inventories, result.Inventories...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listInventoriesOptions, func(o *ListInventoriesOptions) *int64 { return o.Offset }),
+		optionsField(listInventoriesOptions, func(o *ListInventoriesOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetResourcesQueryWithContext gets a resource query from the region encoded in its ID.
+func (router *Router) GetResourcesQueryWithContext(ctx context.Context, getResourcesQueryOptions *GetResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getResourcesQueryOptions, func(o *GetResourcesQueryOptions) *string { return o.QueryID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetResourcesQueryWithContext(ctx, getResourcesQueryOptions)
+}
+
+// ReplaceResourcesQueryWithContext replaces a resource query in the region encoded in its ID.
+func (router *Router) ReplaceResourcesQueryWithContext(ctx context.Context, replaceResourcesQueryOptions *ReplaceResourcesQueryOptions) (*ResourceQueryRecord, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(replaceResourcesQueryOptions, func(o *ReplaceResourcesQueryOptions) *string { return o.QueryID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.ReplaceResourcesQueryWithContext(ctx, replaceResourcesQueryOptions)
+}
+
+// DeleteResourcesQueryWithContext deletes a resource query in the region encoded in its ID.
+func (router *Router) DeleteResourcesQueryWithContext(ctx context.Context, deleteResourcesQueryOptions *DeleteResourcesQueryOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteResourcesQueryOptions, func(o *DeleteResourcesQueryOptions) *string { return o.QueryID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteResourcesQueryWithContext(ctx, deleteResourcesQueryOptions)
+}
+
+// ListResourceQueryWithContext lists the resource queries of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the resource queries of the other regions are returned together with a RegionErrors.
+func (router *Router) ListResourceQueryWithContext(ctx context.Context, listResourceQueryOptions *ListResourceQueryOptions) (*ResourceQueryRecordList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*ResourceQueryRecordList, *core.DetailedResponse, error) {
+		return client.ListResourceQueryWithContext(ctx, listResourceQueryOptions)
+	})
+
+	merged := &ResourceQueryRecordList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.ResourceQueries = append(merged.ResourceQueries, result.ResourceQueries...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listResourceQueryOptions, func(o *ListResourceQueryOptions) *int64 { return o.Offset }),
+		optionsField(listResourceQueryOptions, func(o *ListResourceQueryOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetAgentDataWithContext gets an agent from the region encoded in its ID.
+func (router *Router) GetAgentDataWithContext(ctx context.Context, getAgentDataOptions *GetAgentDataOptions) (*AgentData, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getAgentDataOptions, func(o *GetAgentDataOptions) *string { return o.AgentID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetAgentDataWithContext(ctx, getAgentDataOptions)
+}
+
+// UpdateAgentDataWithContext updates an agent in the region encoded in its ID.
+func (router *Router) UpdateAgentDataWithContext(ctx context.Context, updateAgentDataOptions *UpdateAgentDataOptions) (*AgentData, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(updateAgentDataOptions, func(o *UpdateAgentDataOptions) *string { return o.AgentID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdateAgentDataWithContext(ctx, updateAgentDataOptions)
+}
+
+// DeleteAgentDataWithContext deletes an agent in the region encoded in its ID.
+func (router *Router) DeleteAgentDataWithContext(ctx context.Context, deleteAgentDataOptions *DeleteAgentDataOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deleteAgentDataOptions, func(o *DeleteAgentDataOptions) *string { return o.AgentID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteAgentDataWithContext(ctx, deleteAgentDataOptions)
+}
+
+// CreateAgentDataWithContext registers an agent in the region named by its SchematicsLocation.
+func (router *Router) CreateAgentDataWithContext(ctx context.Context, createAgentDataOptions *CreateAgentDataOptions) (*AgentData, *core.DetailedResponse, error) {
+	client, err := router.clientForLocation(optionsField(createAgentDataOptions, func(o *CreateAgentDataOptions) *string { return o.SchematicsLocation }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateAgentDataWithContext(ctx, createAgentDataOptions)
+}
+
+// ListAgentDataWithContext lists the agents of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the agents of the other regions are returned together with a RegionErrors.
+func (router *Router) ListAgentDataWithContext(ctx context.Context, listAgentDataOptions *ListAgentDataOptions) (*AgentDataList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*AgentDataList, *core.DetailedResponse, error) {
+		return client.ListAgentDataWithContext(ctx, listAgentDataOptions)
+	})
+
+	merged := &AgentDataList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Agents = append(merged.Agents, result.Agents...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listAgentDataOptions, func(o *ListAgentDataOptions) *int64 { return o.Offset }),
+		optionsField(listAgentDataOptions, func(o *ListAgentDataOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// GetPolicyWithContext gets a policy from the region encoded in its ID.
+func (router *Router) GetPolicyWithContext(ctx context.Context, getPolicyOptions *GetPolicyOptions) (*Policy, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(getPolicyOptions, func(o *GetPolicyOptions) *string { return o.PolicyID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetPolicyWithContext(ctx, getPolicyOptions)
+}
+
+// UpdatePolicyWithContext updates a policy in the region encoded in its ID.
+func (router *Router) UpdatePolicyWithContext(ctx context.Context, updatePolicyOptions *UpdatePolicyOptions) (*Policy, *core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(updatePolicyOptions, func(o *UpdatePolicyOptions) *string { return o.PolicyID }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdatePolicyWithContext(ctx, updatePolicyOptions)
+}
+
+// DeletePolicyWithContext deletes a policy in the region encoded in its ID.
+func (router *Router) DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *DeletePolicyOptions) (*core.DetailedResponse, error) {
+	client, err := router.clientForID(optionsField(deletePolicyOptions, func(o *DeletePolicyOptions) *string { return o.PolicyID }))
+	if err != nil {
+		return nil, err
+	}
+	return client.DeletePolicyWithContext(ctx, deletePolicyOptions)
+}
+
+// CreatePolicyWithContext creates a policy in the region named by its Location.
+func (router *Router) CreatePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (*Policy, *core.DetailedResponse, error) {
+	client, err := router.clientForLocation(optionsField(createPolicyOptions, func(o *CreatePolicyOptions) *string { return o.Location }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreatePolicyWithContext(ctx, createPolicyOptions)
+}
+
+// ListPolicyWithContext lists the policies of every region.
+// Offset and Limit apply to each region, so the merged list has the requested Offset and Limit and the sum of the
+// regional counts.
+// If some regions fail, the policies of the other regions are returned together with a RegionErrors.
+func (router *Router) ListPolicyWithContext(ctx context.Context, listPolicyOptions *ListPolicyOptions) (*PolicyList, error) {
+	results, err := fanOut(ctx, router, func(ctx context.Context, client *SchematicsV1) (*PolicyList, *core.DetailedResponse, error) {
+		return client.ListPolicyWithContext(ctx, listPolicyOptions)
+	})
+
+	merged := &PolicyList{TotalCount: core.Int64Ptr(0)}
+	for _, region := range sortedKeys(results) {
+		result := results[region]
+		if result == nil {
+			continue
+		}
+		merged.Policies = append(merged.Policies, result.Policies...)
+		*merged.TotalCount += int64Value(result.TotalCount)
+		if merged.Limit == nil {
+			merged.Limit = result.Limit
+		}
+	}
+	merged.Offset, merged.Limit = mergedPaging(
+		optionsField(listPolicyOptions, func(o *ListPolicyOptions) *int64 { return o.Offset }),
+		optionsField(listPolicyOptions, func(o *ListPolicyOptions) *int64 { return o.Limit }),
+		merged.Limit)
+	return merged, err
+}
+
+// optionsField returns a field of an options struct, or nil if the options struct itself is nil.
+func optionsField[T any, F any](options *T, field func(*T) *F) *F {
+	if options == nil {
+		return nil
+	}
+	return field(options)
+}
+
+// mergedPaging returns the Offset and Limit of a list merged from every region. Each region applied the requested
+// offset and limit, so those are returned; without a requested limit, the default limit of the service is returned.
+func mergedPaging(offset *int64, limit *int64, serviceLimit *int64) (*int64, *int64) {
+	if limit == nil {
+		limit = serviceLimit
+	}
+	return core.Int64Ptr(int64Value(offset)), limit
+}
+
+// int64Value returns the value of an optional int64, or 0 if it is nil.
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
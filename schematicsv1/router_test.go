@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 router`, func() {
+	var usServer *httptest.Server
+	var euServer *httptest.Server
+	var homeServer *httptest.Server
+
+	regionalHandler := func(region string) http.HandlerFunc {
+		return func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.URL.Path == "/v1/workspaces" && req.Method == "GET":
+				if region == "eu-de" && req.URL.Query().Get("resource_group") == "fail" {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"error": "boom"}`)
+					return
+				}
+				if region == "eu-de" && req.URL.Query().Get("resource_group") == "empty" {
+					res.WriteHeader(204)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"count": 1, "limit": 100, "offset": 0, "workspaces": [{"id": "%s.workspace.ws.1", "location": "%s"}]}`, region, region)
+			case req.URL.Path == "/v1/workspaces" && req.Method == "POST":
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "%s.workspace.new.1"}`, region)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "location": "%s", "schematics_location": "%s"}`, req.URL.Path, region, region)
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		usServer = httptest.NewServer(regionalHandler("us-south"))
+		euServer = httptest.NewServer(regionalHandler("eu-de"))
+		homeServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/v2/locations"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"locations": [{"region": "us-south", "schematics_regional_public_endpoint": "%s"}, {"region": "eu-de", "schematics_regional_public_endpoint": "%s"}, {"geography": "unnamed"}]}`, usServer.URL, euServer.URL)
+		}))
+	})
+	AfterEach(func() {
+		usServer.Close()
+		euServer.Close()
+		homeServer.Close()
+	})
+
+	newRouter := func() *schematicsv1.Router {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           homeServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		router, err := schematicsv1.NewRouter(schematicsService, "")
+		Expect(err).To(BeNil())
+		Expect(router.DiscoverRegions(context.Background())).To(BeNil())
+		Expect(router.Regions()).To(Equal([]string{"eu-de", "us-south"}))
+		return router
+	}
+
+	It(`Extract the region from a resource ID`, func() {
+		region, ok := schematicsv1.RegionFromID("us-east.workspace.name.1a2b3c4d")
+		Expect(ok).To(BeTrue())
+		Expect(region).To(Equal("us-east"))
+
+		_, ok = schematicsv1.RegionFromID("no-region")
+		Expect(ok).To(BeFalse())
+	})
+	It(`Construct a router for known regions`, func() {
+		schematicsService, serviceErr := schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		router, err := schematicsv1.NewRouter(schematicsService, schematicsv1.EndpointTypePrivate, "us-south", "eu-gb")
+		Expect(err).To(BeNil())
+		client, err := router.Client("eu-gb")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://private-eu-gb.schematics.cloud.ibm.com"))
+
+		router, err = schematicsv1.NewRouter(schematicsService, "", "INVALID_REGION")
+		Expect(err).ToNot(BeNil())
+		Expect(router).To(BeNil())
+	})
+	It(`Route operations on existing resources by ID`, func() {
+		router := newRouter()
+
+		result, _, err := router.GetWorkspaceWithContext(context.Background(), &schematicsv1.GetWorkspaceOptions{
+			WID: core.StringPtr("eu-de.workspace.ws.1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*result.Location).To(Equal("eu-de"))
+
+		action, _, err := router.GetActionWithContext(context.Background(), &schematicsv1.GetActionOptions{
+			ActionID: core.StringPtr("us-south.ACTION.act.1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*action.Location).To(Equal("us-south"))
+
+		agent, _, err := router.GetAgentDataWithContext(context.Background(), &schematicsv1.GetAgentDataOptions{
+			AgentID: core.StringPtr("eu-de.agent.agent.1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*agent.SchematicsLocation).To(Equal("eu-de"))
+
+		policy, _, err := router.GetPolicyWithContext(context.Background(), &schematicsv1.GetPolicyOptions{
+			PolicyID: core.StringPtr("us-south.policy.policy.1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*policy.Location).To(Equal("us-south"))
+
+		_, _, err = router.GetWorkspaceWithContext(context.Background(), &schematicsv1.GetWorkspaceOptions{
+			WID: core.StringPtr("ca-tor.workspace.ws.1"),
+		})
+		Expect(err).ToNot(BeNil())
+
+		_, _, err = router.GetWorkspaceWithContext(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Route create operations by location`, func() {
+		router := newRouter()
+
+		result, _, err := router.CreateWorkspaceWithContext(context.Background(), &schematicsv1.CreateWorkspaceOptions{
+			Location: core.StringPtr("us-south"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("us-south.workspace.new.1"))
+
+		_, _, err = router.CreateWorkspaceWithContext(context.Background(), &schematicsv1.CreateWorkspaceOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Fan out list operations and merge the results`, func() {
+		router := newRouter()
+
+		result, err := router.ListWorkspacesWithContext(context.Background(), &schematicsv1.ListWorkspacesOptions{})
+		Expect(err).To(BeNil())
+		Expect(*result.Count).To(Equal(int64(2)))
+		Expect(result.Workspaces).To(HaveLen(2))
+		Expect(*result.Workspaces[0].ID).To(Equal("eu-de.workspace.ws.1"))
+		Expect(*result.Workspaces[1].ID).To(Equal("us-south.workspace.ws.1"))
+		Expect(*result.Offset).To(Equal(int64(0)))
+		Expect(*result.Limit).To(Equal(int64(100)))
+	})
+	It(`Return the requested paging of merged lists`, func() {
+		router := newRouter()
+
+		for i := 0; i < 5; i++ {
+			result, err := router.ListWorkspacesWithContext(context.Background(), &schematicsv1.ListWorkspacesOptions{
+				Offset: core.Int64Ptr(5),
+				Limit:  core.Int64Ptr(10),
+			})
+			Expect(err).To(BeNil())
+			Expect(*result.Offset).To(Equal(int64(5)))
+			Expect(*result.Limit).To(Equal(int64(10)))
+			Expect(*result.Count).To(Equal(int64(2)))
+		}
+	})
+	It(`Skip regions that return no result`, func() {
+		router := newRouter()
+
+		result, err := router.ListWorkspacesWithContext(context.Background(), &schematicsv1.ListWorkspacesOptions{
+			ResourceGroup: core.StringPtr("empty"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*result.Count).To(Equal(int64(1)))
+		Expect(result.Workspaces).To(HaveLen(1))
+		Expect(*result.Workspaces[0].ID).To(Equal("us-south.workspace.ws.1"))
+	})
+	It(`Return partial results with regional errors`, func() {
+		router := newRouter()
+
+		result, err := router.ListWorkspacesWithContext(context.Background(), &schematicsv1.ListWorkspacesOptions{
+			ResourceGroup: core.StringPtr("fail"),
+		})
+		Expect(err).ToNot(BeNil())
+		regionErrors, ok := err.(schematicsv1.RegionErrors)
+		Expect(ok).To(BeTrue())
+		Expect(regionErrors).To(HaveKey("eu-de"))
+		Expect(result.Workspaces).To(HaveLen(1))
+		Expect(*result.Workspaces[0].ID).To(Equal("us-south.workspace.ws.1"))
+	})
+})
@@ -0,0 +1,311 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// DefaultRunActionPollInterval is the interval between status checks of an action job if
+// RunActionOptions.PollInterval is not set.
+const DefaultRunActionPollInterval = 10 * time.Second
+
+// runActionFinalStatusCodes are the status codes of action jobs that are no longer running.
+var runActionFinalStatusCodes = []string{
+	JobStatusAction_StatusCode_JobFinished,
+	JobStatusAction_StatusCode_JobFailed,
+	JobStatusAction_StatusCode_JobCancelled,
+	JobStatusAction_StatusCode_JobStopped,
+}
+
+// Patterns of the lines of an ansible-playbook log. Lines of job logs may start with a timestamp, so the patterns
+// are not anchored at the start of lines.
+var (
+	ansibleColorPattern   = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	ansiblePlayPattern    = regexp.MustCompile(`PLAY \[(.*)\] \*+`)
+	ansibleTaskPattern    = regexp.MustCompile(`TASK \[(.*)\] \*+`)
+	ansibleFailurePattern = regexp.MustCompile(`(?:fatal|failed): \[([^\]]+)\](?: \(item=(.*?)\))?(?:: (FAILED|UNREACHABLE)!)? => (.*)$`)
+	ansibleRecapPattern   = regexp.MustCompile(`(\S+)\s+:\s+ok=(\d+)\s+changed=(\d+)\s+unreachable=(\d+)\s+failed=(\d+)(?:\s+skipped=(\d+))?(?:\s+rescued=(\d+))?(?:\s+ignored=(\d+))?`)
+)
+
+// RunActionOptions : the options of RunAction.
+type RunActionOptions struct {
+	// The IAM refresh token to create the job with. If it is not set and the service uses an IamAuthenticator, a
+	// refresh token is requested from the authenticator.
+	RefreshToken string
+
+	// Run the playbook in check mode (ansible_playbook_check) instead of running it.
+	Check bool
+
+	// The inputs of the job, which override the inputs of the action.
+	Inputs []VariableData
+
+	// The settings (environment variables) of the job, which override the settings of the action.
+	Settings []VariableData
+
+	// The command line options of the job.
+	CommandOptions []string
+
+	// The tags of the job.
+	Tags []string
+
+	// The interval between status checks of the job; DefaultRunActionPollInterval if 0.
+	PollInterval time.Duration
+
+	// Headers to set on the requests of the job.
+	Headers map[string]string
+}
+
+// RunActionResult : the result of an action job.
+type RunActionResult struct {
+	// The job, as of its final status.
+	Job *Job
+
+	// The final status code of the job (JobStatusAction_StatusCode_*).
+	StatusCode string
+
+	// The counts of the log summary of the job (targets, tasks and plays, and the totals of the recap).
+	Summary *JobLogSummaryActionJob
+
+	// The recap of each host, parsed from the log.
+	Recap []AnsibleHostRecap
+
+	// The tasks that failed, parsed from the log.
+	FailedTasks []AnsibleTaskFailure
+
+	// The log of the job.
+	Log string
+}
+
+// AnsibleHostRecap : the PLAY RECAP line of a host in an ansible-playbook log.
+type AnsibleHostRecap struct {
+	Host        string
+	Ok          int
+	Changed     int
+	Unreachable int
+	Failed      int
+	Skipped     int
+	Rescued     int
+	Ignored     int
+}
+
+// AnsibleTaskFailure : a task that failed on a host in an ansible-playbook log.
+type AnsibleTaskFailure struct {
+	// The names of the play and of the task.
+	Play string
+	Task string
+
+	// The host, and the loop item if the task failed for an item of a loop.
+	Host string
+	Item string
+
+	// Whether the host was unreachable, rather than the task failing.
+	Unreachable bool
+
+	// Whether the failure was ignored (ignore_errors), so that the play went on.
+	Ignored bool
+
+	// The message of the failure: the "msg" of its result if the result is JSON, otherwise the result.
+	Message string
+}
+
+// AnsiblePlaybookLog : what ParseAnsiblePlaybookLog found in an ansible-playbook log.
+type AnsiblePlaybookLog struct {
+	Recap       []AnsibleHostRecap
+	FailedTasks []AnsibleTaskFailure
+}
+
+// RunAction runs a playbook of an action and waits for the job to end. It creates the job with CreateJob
+// (command object action and command name ansible_playbook_run, or ansible_playbook_check if
+// runActionOptions.Check is set), polls it with GetJob until it is no longer running, and reads its log with
+// ListJobLogs.
+//
+// If the job does not finish successfully, the result is returned along with an error. If the log cannot be read,
+// the result is returned without its recap and failed tasks, along with the error. If ctx is done while the job
+// runs, the job is left running and its ID is in the returned result.
+func (schematics *SchematicsV1) RunAction(ctx context.Context, actionID string, playbook string, runActionOptions *RunActionOptions) (result *RunActionResult, err error) {
+	if runActionOptions == nil {
+		runActionOptions = &RunActionOptions{}
+	}
+	refreshToken := runActionOptions.RefreshToken
+	if iamAuthenticator, ok := schematics.Service.Options.Authenticator.(*core.IamAuthenticator); ok && refreshToken == "" {
+		token, tokenErr := iamAuthenticator.RequestToken()
+		if tokenErr != nil {
+			err = core.SDKErrorf(tokenErr, "", "refresh-token-error", common.GetComponentInfo())
+			return
+		}
+		refreshToken = token.RefreshToken
+	}
+	commandName := Job_CommandName_AnsiblePlaybookRun
+	if runActionOptions.Check {
+		commandName = Job_CommandName_AnsiblePlaybookCheck
+	}
+
+	createJobOptions := schematics.NewCreateJobOptions(refreshToken)
+	createJobOptions.CommandObject = core.StringPtr(Job_CommandObject_Action)
+	createJobOptions.CommandObjectID = core.StringPtr(actionID)
+	createJobOptions.CommandName = core.StringPtr(commandName)
+	createJobOptions.CommandParameter = core.StringPtr(playbook)
+	createJobOptions.CommandOptions = runActionOptions.CommandOptions
+	createJobOptions.Inputs = runActionOptions.Inputs
+	createJobOptions.Settings = runActionOptions.Settings
+	createJobOptions.Tags = runActionOptions.Tags
+	createJobOptions.Headers = runActionOptions.Headers
+	job, _, err := schematics.CreateJobWithContext(ctx, createJobOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-job-error")
+		return
+	}
+	result = &RunActionResult{Job: job}
+
+	pollInterval := runActionOptions.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultRunActionPollInterval
+	}
+	getJobOptions := schematics.NewGetJobOptions(*job.ID)
+	getJobOptions.Headers = runActionOptions.Headers
+	for {
+		result.StatusCode = actionJobStatusCode(result.Job)
+		if slices.Contains(runActionFinalStatusCodes, result.StatusCode) {
+			break
+		}
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), fmt.Sprintf("stopped waiting for job '%s'", *job.ID), "run-action-wait-error", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+		job, _, err = schematics.GetJobWithContext(ctx, getJobOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-job-error")
+			return
+		}
+		result.Job = job
+	}
+	if result.Job.LogSummary != nil {
+		result.Summary = result.Job.LogSummary.ActionJob
+	}
+
+	listJobLogsOptions := schematics.NewListJobLogsOptions(*job.ID)
+	listJobLogsOptions.Headers = runActionOptions.Headers
+	jobLog, _, err := schematics.ListJobLogsWithContext(ctx, listJobLogsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-job-logs-error")
+		return
+	}
+	if jobLog.LogSummary != nil && jobLog.LogSummary.ActionJob != nil {
+		result.Summary = jobLog.LogSummary.ActionJob
+	}
+	if jobLog.Details != nil {
+		result.Log = string(*jobLog.Details)
+	}
+	parsed := ParseAnsiblePlaybookLog(result.Log)
+	result.Recap = parsed.Recap
+	result.FailedTasks = parsed.FailedTasks
+
+	if result.StatusCode != JobStatusAction_StatusCode_JobFinished {
+		message := fmt.Sprintf("job '%s' of action '%s' ended with status '%s'", *job.ID, actionID, result.StatusCode)
+		if result.Job.Status != nil && result.Job.Status.ActionJobStatus != nil && result.Job.Status.ActionJobStatus.StatusMessage != nil {
+			message += ": " + *result.Job.Status.ActionJobStatus.StatusMessage
+		}
+		err = core.SDKErrorf(nil, message, "run-action-job-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func actionJobStatusCode(job *Job) string {
+	if job == nil || job.Status == nil || job.Status.ActionJobStatus == nil {
+		return ""
+	}
+	return core.StringNilMapper(job.Status.ActionJobStatus.StatusCode)
+}
+
+// ParseAnsiblePlaybookLog returns the PLAY RECAP lines and the failed tasks of an ansible-playbook log, such as the
+// log of an action job. Terminal colors and the timestamps that start the lines of job logs are ignored.
+func ParseAnsiblePlaybookLog(log string) *AnsiblePlaybookLog {
+	parsed := &AnsiblePlaybookLog{}
+	var play, task string
+	inRecap := false
+	for _, line := range strings.Split(ansibleColorPattern.ReplaceAllString(log, ""), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if match := ansiblePlayPattern.FindStringSubmatch(line); match != nil {
+			play, task, inRecap = match[1], "", false
+		} else if strings.Contains(line, "PLAY RECAP *") {
+			inRecap = true
+		} else if match := ansibleTaskPattern.FindStringSubmatch(line); match != nil {
+			task = match[1]
+		} else if match := ansibleFailurePattern.FindStringSubmatch(line); match != nil && !inRecap {
+			parsed.FailedTasks = append(parsed.FailedTasks, AnsibleTaskFailure{
+				Play:        play,
+				Task:        task,
+				Host:        match[1],
+				Item:        match[2],
+				Unreachable: match[3] == "UNREACHABLE",
+				Message:     ansibleFailureMessage(match[4]),
+			})
+		} else if strings.Contains(line, "...ignoring") && len(parsed.FailedTasks) > 0 {
+			parsed.FailedTasks[len(parsed.FailedTasks)-1].Ignored = true
+		} else if match := ansibleRecapPattern.FindStringSubmatch(line); match != nil && inRecap {
+			counts := make([]int, 7)
+			for i := range counts {
+				counts[i], _ = strconv.Atoi(match[i+2])
+			}
+			parsed.Recap = append(parsed.Recap, AnsibleHostRecap{
+				Host:        match[1],
+				Ok:          counts[0],
+				Changed:     counts[1],
+				Unreachable: counts[2],
+				Failed:      counts[3],
+				Skipped:     counts[4],
+				Rescued:     counts[5],
+				Ignored:     counts[6],
+			})
+		}
+	}
+	return parsed
+}
+
+// ansibleFailureMessage returns the "msg" of the result of a failed task, or the result if it is not a JSON object
+// with a message.
+func ansibleFailureMessage(result string) string {
+	result = strings.TrimSpace(result)
+	var fields map[string]interface{}
+	if json.Unmarshal([]byte(result), &fields) != nil {
+		return result
+	}
+	switch msg := fields["msg"].(type) {
+	case string:
+		return msg
+	case nil:
+		return result
+	default:
+		encoded, _ := json.Marshal(msg)
+		return string(encoded)
+	}
+}
@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 RunAction`, func() {
+	const playbookLog = "2024/05/15 10:00:00 Starting job\n" +
+		" 2024/05/15 10:00:01 \x1b[0;32mPLAY [Configure web servers] ***************************\x1b[0m\n" +
+		" 2024/05/15 10:00:02 TASK [Gathering Facts] *********************************\n" +
+		" 2024/05/15 10:00:03 ok: [10.0.0.1]\n" +
+		" 2024/05/15 10:00:03 fatal: [10.0.0.3]: UNREACHABLE! => {\"changed\": false, \"msg\": \"Failed to connect to the host via ssh\", \"unreachable\": true}\n" +
+		" 2024/05/15 10:00:04 TASK [Install nginx] ***********************************\n" +
+		" 2024/05/15 10:00:05 failed: [10.0.0.1] (item=nginx) => {\"ansible_loop_var\": \"item\", \"item\": \"nginx\", \"msg\": \"No package matching 'nginx' is available\"}\n" +
+		" 2024/05/15 10:00:05 fatal: [10.0.0.2]: FAILED! => {\"changed\": false, \"msg\": [\"first\", \"second\"]}\n" +
+		" 2024/05/15 10:00:05 ...ignoring\n" +
+		" 2024/05/15 10:00:06 fatal: [10.0.0.4]: FAILED! => not json\n" +
+		" 2024/05/15 10:00:07 PLAY RECAP *********************************************\n" +
+		" 2024/05/15 10:00:07 10.0.0.1                   : ok=1    changed=0    unreachable=0    failed=1    skipped=0    rescued=0    ignored=0\n" +
+		" 2024/05/15 10:00:07 10.0.0.2                   : ok=2    changed=1    unreachable=0    failed=0    skipped=3    rescued=0    ignored=1\n" +
+		" 2024/05/15 10:00:07 10.0.0.3                   : ok=0    changed=0    unreachable=1    failed=0\n"
+
+	It(`Parse an ansible-playbook log`, func() {
+		parsed := schematicsv1.ParseAnsiblePlaybookLog(playbookLog)
+		Expect(parsed.Recap).To(Equal([]schematicsv1.AnsibleHostRecap{
+			{Host: "10.0.0.1", Ok: 1, Failed: 1},
+			{Host: "10.0.0.2", Ok: 2, Changed: 1, Skipped: 3, Ignored: 1},
+			{Host: "10.0.0.3", Unreachable: 1},
+		}))
+		Expect(parsed.FailedTasks).To(Equal([]schematicsv1.AnsibleTaskFailure{
+			{Play: "Configure web servers", Task: "Gathering Facts", Host: "10.0.0.3", Unreachable: true, Message: "Failed to connect to the host via ssh"},
+			{Play: "Configure web servers", Task: "Install nginx", Host: "10.0.0.1", Item: "nginx", Message: "No package matching 'nginx' is available"},
+			{Play: "Configure web servers", Task: "Install nginx", Host: "10.0.0.2", Ignored: true, Message: `["first","second"]`},
+			{Play: "Configure web servers", Task: "Install nginx", Host: "10.0.0.4", Message: "not json"},
+		}))
+
+		Expect(schematicsv1.ParseAnsiblePlaybookLog("")).To(Equal(&schematicsv1.AnsiblePlaybookLog{}))
+	})
+
+	Describe(`Run a playbook of an action`, func() {
+		var testServer *httptest.Server
+		var schematicsService *schematicsv1.SchematicsV1
+		var created map[string]interface{}
+		var polls int32
+		var finalStatus string
+
+		BeforeEach(func() {
+			created = nil
+			polls = 0
+			finalStatus = schematicsv1.JobStatusAction_StatusCode_JobFinished
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.URL.Path == "/v2/jobs" && req.Method == http.MethodPost:
+					Expect(req.Header.Get("refresh_token")).To(Equal("token"))
+					Expect(json.NewDecoder(req.Body).Decode(&created)).To(Succeed())
+					fmt.Fprint(res, `{"id": "job1", "status": {"action_job_status": {"status_code": "job_pending"}}}`)
+				case req.URL.Path == "/v2/jobs/job1":
+					status := schematicsv1.JobStatusAction_StatusCode_JobInProgress
+					if atomic.AddInt32(&polls, 1) > 1 {
+						status = finalStatus
+					}
+					fmt.Fprintf(res, `{"id": "job1", "status": {"action_job_status": {"status_code": %q, "status_message": "2 hosts failed"}},
+						"log_summary": {"action_job": {"target_count": 3, "task_count": 2, "play_count": 1}}}`, status)
+				case req.URL.Path == "/v2/jobs/job1/logs":
+					body, _ := json.Marshal(map[string]interface{}{"job_id": "job1", "details": []byte(playbookLog)})
+					res.Write(body)
+				default:
+					res.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+				}
+			}))
+			var serviceErr error
+			schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Create the job, wait for it and parse its log`, func() {
+			result, err := schematicsService.RunAction(context.Background(), "action1", "site.yml", &schematicsv1.RunActionOptions{
+				RefreshToken: "token",
+				Inputs:       []schematicsv1.VariableData{{Name: core.StringPtr("env"), Value: core.StringPtr("prod")}},
+				PollInterval: time.Millisecond,
+			})
+			Expect(err).To(BeNil())
+			Expect(created["command_object"]).To(Equal("action"))
+			Expect(created["command_object_id"]).To(Equal("action1"))
+			Expect(created["command_name"]).To(Equal("ansible_playbook_run"))
+			Expect(created["command_parameter"]).To(Equal("site.yml"))
+			Expect(created["inputs"]).To(HaveLen(1))
+
+			Expect(polls).To(Equal(int32(2)))
+			Expect(*result.Job.ID).To(Equal("job1"))
+			Expect(result.StatusCode).To(Equal(schematicsv1.JobStatusAction_StatusCode_JobFinished))
+			Expect(*result.Summary.TargetCount).To(Equal(float64(3)))
+			Expect(result.Recap).To(HaveLen(3))
+			Expect(result.FailedTasks).To(HaveLen(4))
+			Expect(result.Log).To(Equal(playbookLog))
+		})
+		It(`Return the result of a failed job with an error`, func() {
+			finalStatus = schematicsv1.JobStatusAction_StatusCode_JobFailed
+			result, err := schematicsService.RunAction(context.Background(), "action1", "site.yml", &schematicsv1.RunActionOptions{
+				RefreshToken: "token",
+				Check:        true,
+				PollInterval: time.Millisecond,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("job 'job1' of action 'action1' ended with status 'job_failed': 2 hosts failed"))
+			Expect(created["command_name"]).To(Equal("ansible_playbook_check"))
+			Expect(result.StatusCode).To(Equal(schematicsv1.JobStatusAction_StatusCode_JobFailed))
+			Expect(result.Recap[0].Failed).To(Equal(1))
+		})
+		It(`Stop waiting when the context is done`, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			result, err := schematicsService.RunAction(ctx, "action1", "site.yml", &schematicsv1.RunActionOptions{RefreshToken: "token"})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("stopped waiting for job 'job1'"))
+			Expect(*result.Job.ID).To(Equal("job1"))
+		})
+	})
+})
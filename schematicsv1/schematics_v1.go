@@ -45,6 +45,8 @@ import (
 // API Version: 1.0
 type SchematicsV1 struct {
 	Service *core.BaseService
+
+	middleware []Middleware
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -121,9 +123,11 @@ func NewSchematicsV1(options *SchematicsV1Options) (service *SchematicsV1, err e
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
+// GetServiceURLForRegion returns the service URL to be used for the specified region.
+// The region may be qualified with an endpoint type, e.g. "us-south" or "private.us-south".
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	endpointType, region := splitRegionEndpointType(region)
+	return GetServiceURLForRegionEndpoint(region, endpointType)
 }
 
 // Clone makes a copy of "schematics" suitable for processing requests.
@@ -212,7 +216,7 @@ func (schematics *SchematicsV1) ListSchematicsLocationWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListSchematicsLocation")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListSchematicsLocation", listSchematicsLocationOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -224,21 +228,24 @@ func (schematics *SchematicsV1) ListSchematicsLocationWithContext(ctx context.Co
 		return
 	}
 
-	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_schematics_location", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchematicsLocations)
+	response, err = schematics.invoke(ctx, "ListSchematicsLocation", listSchematicsLocationOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse []json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_schematics_location", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchematicsLocations)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -279,7 +286,7 @@ func (schematics *SchematicsV1) ListLocationsWithContext(ctx context.Context, li
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListLocations")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListLocations", listLocationsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -291,21 +298,24 @@ func (schematics *SchematicsV1) ListLocationsWithContext(ctx context.Context, li
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_locations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchematicsLocationsList)
+	response, err = schematics.invoke(ctx, "ListLocations", listLocationsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_locations", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchematicsLocationsList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -346,7 +356,7 @@ func (schematics *SchematicsV1) ListResourceGroupWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListResourceGroup")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListResourceGroup", listResourceGroupOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -358,21 +368,24 @@ func (schematics *SchematicsV1) ListResourceGroupWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_resource_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceGroupResponse)
+	response, err = schematics.invoke(ctx, "ListResourceGroup", listResourceGroupOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse []json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_resource_group", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceGroupResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -407,7 +420,7 @@ func (schematics *SchematicsV1) GetSchematicsVersionWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetSchematicsVersion")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetSchematicsVersion", getSchematicsVersionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -419,21 +432,24 @@ func (schematics *SchematicsV1) GetSchematicsVersionWithContext(ctx context.Cont
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_schematics_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalVersionResponse)
+	response, err = schematics.invoke(ctx, "GetSchematicsVersion", getSchematicsVersionOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_schematics_version", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalVersionResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -480,7 +496,7 @@ func (schematics *SchematicsV1) ProcessTemplateMetaDataWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ProcessTemplateMetaData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ProcessTemplateMetaData", processTemplateMetaDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -515,21 +531,24 @@ func (schematics *SchematicsV1) ProcessTemplateMetaDataWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "ProcessTemplateMetaData", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateMetaDataResponse)
+	response, err = schematics.invoke(ctx, "ProcessTemplateMetaData", processTemplateMetaDataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "ProcessTemplateMetaData", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateMetaDataResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -574,7 +593,7 @@ func (schematics *SchematicsV1) ListWorkspacesWithContext(ctx context.Context, l
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListWorkspaces")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListWorkspaces", listWorkspacesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -599,21 +618,24 @@ func (schematics *SchematicsV1) ListWorkspacesWithContext(ctx context.Context, l
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_workspaces", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponseList)
+	response, err = schematics.invoke(ctx, "ListWorkspaces", listWorkspacesOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_workspaces", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponseList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -687,7 +709,7 @@ func (schematics *SchematicsV1) CreateWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateWorkspace")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateWorkspace", createWorkspaceOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -758,21 +780,24 @@ func (schematics *SchematicsV1) CreateWorkspaceWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_workspace", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+	response, err = schematics.invoke(ctx, "CreateWorkspace", createWorkspaceOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_workspace", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -821,7 +846,7 @@ func (schematics *SchematicsV1) GetWorkspaceWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspace")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspace", getWorkspaceOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -833,21 +858,24 @@ func (schematics *SchematicsV1) GetWorkspaceWithContext(ctx context.Context, get
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+	response, err = schematics.invoke(ctx, "GetWorkspace", getWorkspaceOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -901,7 +929,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceWorkspace")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ReplaceWorkspace", replaceWorkspaceOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -963,21 +991,24 @@ func (schematics *SchematicsV1) ReplaceWorkspaceWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "replace_workspace", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+	response, err = schematics.invoke(ctx, "ReplaceWorkspace", replaceWorkspaceOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "replace_workspace", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1033,7 +1064,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteWorkspace")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteWorkspace", deleteWorkspaceOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1052,12 +1083,15 @@ func (schematics *SchematicsV1) DeleteWorkspaceWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_workspace", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteWorkspace", deleteWorkspaceOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, &result)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_workspace", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -1119,7 +1153,7 @@ func (schematics *SchematicsV1) UpdateWorkspaceWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateWorkspace")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateWorkspace", updateWorkspaceOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1178,21 +1212,24 @@ func (schematics *SchematicsV1) UpdateWorkspaceWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_workspace", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+	response, err = schematics.invoke(ctx, "UpdateWorkspace", updateWorkspaceOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_workspace", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1238,7 +1275,7 @@ func (schematics *SchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceReadme")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceReadme", getWorkspaceReadmeOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1257,21 +1294,24 @@ func (schematics *SchematicsV1) GetWorkspaceReadmeWithContext(ctx context.Contex
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_readme", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateReadme)
+	response, err = schematics.invoke(ctx, "GetWorkspaceReadme", getWorkspaceReadmeOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_readme", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateReadme)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1327,7 +1367,7 @@ func (schematics *SchematicsV1) TemplateRepoUploadWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "TemplateRepoUpload")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "TemplateRepoUpload", templateRepoUploadOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1344,21 +1384,24 @@ func (schematics *SchematicsV1) TemplateRepoUploadWithContext(ctx context.Contex
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "template_repo_upload", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateRepoTarUploadResponse)
+	response, err = schematics.invoke(ctx, "TemplateRepoUpload", templateRepoUploadOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "template_repo_upload", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateRepoTarUploadResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1409,7 +1452,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputsWithContext(ctx context.Contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceInputs", getWorkspaceInputsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1421,21 +1464,24 @@ func (schematics *SchematicsV1) GetWorkspaceInputsWithContext(ctx context.Contex
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_inputs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateValues)
+	response, err = schematics.invoke(ctx, "GetWorkspaceInputs", getWorkspaceInputsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_inputs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateValues)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1479,7 +1525,7 @@ func (schematics *SchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ReplaceWorkspaceInputs", replaceWorkspaceInputsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1508,21 +1554,24 @@ func (schematics *SchematicsV1) ReplaceWorkspaceInputsWithContext(ctx context.Co
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "replace_workspace_inputs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUserValues)
+	response, err = schematics.invoke(ctx, "ReplaceWorkspaceInputs", replaceWorkspaceInputsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "replace_workspace_inputs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalUserValues)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1572,7 +1621,7 @@ func (schematics *SchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAllWorkspaceInputs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetAllWorkspaceInputs", getAllWorkspaceInputsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1584,21 +1633,24 @@ func (schematics *SchematicsV1) GetAllWorkspaceInputsWithContext(ctx context.Con
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_all_workspace_inputs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceTemplateValuesResponse)
+	response, err = schematics.invoke(ctx, "GetAllWorkspaceInputs", getAllWorkspaceInputsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_all_workspace_inputs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceTemplateValuesResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1643,7 +1695,7 @@ func (schematics *SchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceInputMetadata")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceInputMetadata", getWorkspaceInputMetadataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1655,12 +1707,15 @@ func (schematics *SchematicsV1) GetWorkspaceInputMetadataWithContext(ctx context
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_input_metadata", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "GetWorkspaceInputMetadata", getWorkspaceInputMetadataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, &result)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "get_workspace_input_metadata", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -1704,7 +1759,7 @@ func (schematics *SchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceOutputs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceOutputs", getWorkspaceOutputsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1716,21 +1771,24 @@ func (schematics *SchematicsV1) GetWorkspaceOutputsWithContext(ctx context.Conte
 		return
 	}
 
-	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_outputs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalOutputValuesInner)
+	response, err = schematics.invoke(ctx, "GetWorkspaceOutputs", getWorkspaceOutputsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse []json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_outputs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalOutputValuesInner)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1773,7 +1831,7 @@ func (schematics *SchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceResources")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceResources", getWorkspaceResourcesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1785,21 +1843,24 @@ func (schematics *SchematicsV1) GetWorkspaceResourcesWithContext(ctx context.Con
 		return
 	}
 
-	var rawResponse []json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_resources", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateResources)
+	response, err = schematics.invoke(ctx, "GetWorkspaceResources", getWorkspaceResourcesOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse []json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_resources", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateResources)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1857,7 +1918,7 @@ func (schematics *SchematicsV1) GetWorkspaceStateWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceState")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceState", getWorkspaceStateOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1869,21 +1930,24 @@ func (schematics *SchematicsV1) GetWorkspaceStateWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_state", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalStateStoreResponseList)
+	response, err = schematics.invoke(ctx, "GetWorkspaceState", getWorkspaceStateOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_state", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalStateStoreResponseList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -1934,7 +1998,7 @@ func (schematics *SchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceTemplateState")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceTemplateState", getWorkspaceTemplateStateOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1946,21 +2010,24 @@ func (schematics *SchematicsV1) GetWorkspaceTemplateStateWithContext(ctx context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_template_state", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateStateStore)
+	response, err = schematics.invoke(ctx, "GetWorkspaceTemplateState", getWorkspaceTemplateStateOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_template_state", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateStateStore)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2015,7 +2082,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceActivityLogs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceActivityLogs", getWorkspaceActivityLogsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2027,21 +2094,24 @@ func (schematics *SchematicsV1) GetWorkspaceActivityLogsWithContext(ctx context.
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_activity_logs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityLogs)
+	response, err = schematics.invoke(ctx, "GetWorkspaceActivityLogs", getWorkspaceActivityLogsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_activity_logs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityLogs)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2088,7 +2158,7 @@ func (schematics *SchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceLogUrls")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceLogUrls", getWorkspaceLogUrlsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2100,21 +2170,24 @@ func (schematics *SchematicsV1) GetWorkspaceLogUrlsWithContext(ctx context.Conte
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_log_urls", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLogStoreResponseList)
+	response, err = schematics.invoke(ctx, "GetWorkspaceLogUrls", getWorkspaceLogUrlsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_log_urls", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalLogStoreResponseList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2165,7 +2238,7 @@ func (schematics *SchematicsV1) GetTemplateLogsWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetTemplateLogs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetTemplateLogs", getTemplateLogsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2190,12 +2263,15 @@ func (schematics *SchematicsV1) GetTemplateLogsWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_template_logs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "GetTemplateLogs", getTemplateLogsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, &result)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "get_template_logs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -2240,7 +2316,7 @@ func (schematics *SchematicsV1) GetTemplateActivityLogWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetTemplateActivityLog")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetTemplateActivityLog", getTemplateActivityLogOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2265,12 +2341,15 @@ func (schematics *SchematicsV1) GetTemplateActivityLogWithContext(ctx context.Co
 		return
 	}
 
-	response, err = schematics.Service.Request(request, &result)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_template_activity_log", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "GetTemplateActivityLog", getTemplateActivityLogOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, &result)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "get_template_activity_log", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -2316,7 +2395,7 @@ func (schematics *SchematicsV1) ListActionsWithContext(ctx context.Context, list
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListActions")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListActions", listActionsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2341,21 +2420,24 @@ func (schematics *SchematicsV1) ListActionsWithContext(ctx context.Context, list
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_actions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalActionList)
+	response, err = schematics.invoke(ctx, "ListActions", listActionsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_actions", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalActionList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2416,7 +2498,7 @@ func (schematics *SchematicsV1) CreateActionWithContext(ctx context.Context, cre
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateAction")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateAction", createActionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2499,21 +2581,24 @@ func (schematics *SchematicsV1) CreateActionWithContext(ctx context.Context, cre
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+	response, err = schematics.invoke(ctx, "CreateAction", createActionOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_action", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2564,7 +2649,7 @@ func (schematics *SchematicsV1) GetActionWithContext(ctx context.Context, getAct
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAction")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetAction", getActionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2580,21 +2665,24 @@ func (schematics *SchematicsV1) GetActionWithContext(ctx context.Context, getAct
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+	response, err = schematics.invoke(ctx, "GetAction", getActionOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_action", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2648,7 +2736,7 @@ func (schematics *SchematicsV1) DeleteActionWithContext(ctx context.Context, del
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAction")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteAction", deleteActionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2665,12 +2753,15 @@ func (schematics *SchematicsV1) DeleteActionWithContext(ctx context.Context, del
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteAction", deleteActionOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_action", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -2730,7 +2821,7 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAction")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateAction", updateActionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2813,21 +2904,24 @@ func (schematics *SchematicsV1) UpdateActionWithContext(ctx context.Context, upd
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+	response, err = schematics.invoke(ctx, "UpdateAction", updateActionOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_action", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAction)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2882,7 +2976,7 @@ func (schematics *SchematicsV1) UploadTemplateTarActionWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UploadTemplateTarAction")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UploadTemplateTarAction", uploadTemplateTarActionOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2899,21 +2993,24 @@ func (schematics *SchematicsV1) UploadTemplateTarActionWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "upload_template_tar_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateRepoTarUploadResponse)
+	response, err = schematics.invoke(ctx, "UploadTemplateTarAction", uploadTemplateTarActionOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "upload_template_tar_action", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateRepoTarUploadResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -2957,7 +3054,7 @@ func (schematics *SchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListWorkspaceActivities")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListWorkspaceActivities", listWorkspaceActivitiesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2976,21 +3073,24 @@ func (schematics *SchematicsV1) ListWorkspaceActivitiesWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_workspace_activities", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivities)
+	response, err = schematics.invoke(ctx, "ListWorkspaceActivities", listWorkspaceActivitiesOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_workspace_activities", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivities)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3035,7 +3135,7 @@ func (schematics *SchematicsV1) GetWorkspaceActivityWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceActivity")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceActivity", getWorkspaceActivityOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3047,21 +3147,24 @@ func (schematics *SchematicsV1) GetWorkspaceActivityWithContext(ctx context.Cont
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_activity", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivity)
+	response, err = schematics.invoke(ctx, "GetWorkspaceActivity", getWorkspaceActivityOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_activity", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivity)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3115,7 +3218,7 @@ func (schematics *SchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteWorkspaceActivity")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteWorkspaceActivity", deleteWorkspaceActivityOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3127,21 +3230,24 @@ func (schematics *SchematicsV1) DeleteWorkspaceActivityWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_workspace_activity", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityApplyResult)
+	response, err = schematics.invoke(ctx, "DeleteWorkspaceActivity", deleteWorkspaceActivityOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "delete_workspace_activity", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityApplyResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3191,7 +3297,7 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RunWorkspaceCommands")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "RunWorkspaceCommands", runWorkspaceCommandsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3223,21 +3329,24 @@ func (schematics *SchematicsV1) RunWorkspaceCommandsWithContext(ctx context.Cont
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "run_workspace_commands", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityCommandResult)
+	response, err = schematics.invoke(ctx, "RunWorkspaceCommands", runWorkspaceCommandsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "run_workspace_commands", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityCommandResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3309,7 +3418,7 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ApplyWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ApplyWorkspaceCommand", applyWorkspaceCommandOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3338,21 +3447,24 @@ func (schematics *SchematicsV1) ApplyWorkspaceCommandWithContext(ctx context.Con
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "apply_workspace_command", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityApplyResult)
+	response, err = schematics.invoke(ctx, "ApplyWorkspaceCommand", applyWorkspaceCommandOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "apply_workspace_command", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityApplyResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3413,7 +3525,7 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DestroyWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DestroyWorkspaceCommand", destroyWorkspaceCommandOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3442,21 +3554,24 @@ func (schematics *SchematicsV1) DestroyWorkspaceCommandWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "destroy_workspace_command", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityDestroyResult)
+	response, err = schematics.invoke(ctx, "DestroyWorkspaceCommand", destroyWorkspaceCommandOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "destroy_workspace_command", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityDestroyResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3517,7 +3632,7 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "PlanWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "PlanWorkspaceCommand", planWorkspaceCommandOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3546,21 +3661,24 @@ func (schematics *SchematicsV1) PlanWorkspaceCommandWithContext(ctx context.Cont
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "plan_workspace_command", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityPlanResult)
+	response, err = schematics.invoke(ctx, "PlanWorkspaceCommand", planWorkspaceCommandOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "plan_workspace_command", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityPlanResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3613,7 +3731,7 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RefreshWorkspaceCommand")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "RefreshWorkspaceCommand", refreshWorkspaceCommandOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3631,21 +3749,24 @@ func (schematics *SchematicsV1) RefreshWorkspaceCommandWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "refresh_workspace_command", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityRefreshResult)
+	response, err = schematics.invoke(ctx, "RefreshWorkspaceCommand", refreshWorkspaceCommandOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "refresh_workspace_command", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceActivityRefreshResult)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3688,7 +3809,7 @@ func (schematics *SchematicsV1) ListJobsWithContext(ctx context.Context, listJob
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListJobs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListJobs", listJobsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3728,21 +3849,24 @@ func (schematics *SchematicsV1) ListJobsWithContext(ctx context.Context, listJob
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_jobs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobList)
+	response, err = schematics.invoke(ctx, "ListJobs", listJobsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_jobs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3782,7 +3906,7 @@ func (schematics *SchematicsV1) CreateJobWithContext(ctx context.Context, create
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateJob", createJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3850,21 +3974,24 @@ func (schematics *SchematicsV1) CreateJobWithContext(ctx context.Context, create
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+	response, err = schematics.invoke(ctx, "CreateJob", createJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3914,7 +4041,7 @@ func (schematics *SchematicsV1) GetJobWithContext(ctx context.Context, getJobOpt
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetJob", getJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3930,21 +4057,24 @@ func (schematics *SchematicsV1) GetJobWithContext(ctx context.Context, getJobOpt
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+	response, err = schematics.invoke(ctx, "GetJob", getJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -3995,7 +4125,7 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateJob", updateJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4063,21 +4193,24 @@ func (schematics *SchematicsV1) UpdateJobWithContext(ctx context.Context, update
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+	response, err = schematics.invoke(ctx, "UpdateJob", updateJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4129,7 +4262,7 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteJob", deleteJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4149,12 +4282,15 @@ func (schematics *SchematicsV1) DeleteJobWithContext(ctx context.Context, delete
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteJob", deleteJobOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -4199,7 +4335,7 @@ func (schematics *SchematicsV1) ListJobLogsWithContext(ctx context.Context, list
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListJobLogs")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListJobLogs", listJobLogsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4211,21 +4347,24 @@ func (schematics *SchematicsV1) ListJobLogsWithContext(ctx context.Context, list
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_job_logs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobLog)
+	response, err = schematics.invoke(ctx, "ListJobLogs", listJobLogsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_job_logs", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobLog)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4270,7 +4409,7 @@ func (schematics *SchematicsV1) GetJobFilesWithContext(ctx context.Context, getJ
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetJobFiles")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetJobFiles", getJobFilesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4284,21 +4423,24 @@ func (schematics *SchematicsV1) GetJobFilesWithContext(ctx context.Context, getJ
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_job_files", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobFileData)
+	response, err = schematics.invoke(ctx, "GetJobFiles", getJobFilesOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_job_files", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalJobFileData)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4345,7 +4487,7 @@ func (schematics *SchematicsV1) CreateWorkspaceDeletionJobWithContext(ctx contex
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateWorkspaceDeletionJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateWorkspaceDeletionJob", createWorkspaceDeletionJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4377,21 +4519,24 @@ func (schematics *SchematicsV1) CreateWorkspaceDeletionJobWithContext(ctx contex
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_workspace_deletion_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceBulkDeleteResponse)
+	response, err = schematics.invoke(ctx, "CreateWorkspaceDeletionJob", createWorkspaceDeletionJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_workspace_deletion_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceBulkDeleteResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4441,7 +4586,7 @@ func (schematics *SchematicsV1) GetWorkspaceDeletionJobStatusWithContext(ctx con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetWorkspaceDeletionJobStatus")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceDeletionJobStatus", getWorkspaceDeletionJobStatusOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4453,21 +4598,24 @@ func (schematics *SchematicsV1) GetWorkspaceDeletionJobStatusWithContext(ctx con
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_workspace_deletion_job_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceJobResponse)
+	response, err = schematics.invoke(ctx, "GetWorkspaceDeletionJobStatus", getWorkspaceDeletionJobStatusOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_workspace_deletion_job_status", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalWorkspaceJobResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4511,7 +4659,7 @@ func (schematics *SchematicsV1) ListInventoriesWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListInventories")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListInventories", listInventoriesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4536,21 +4684,24 @@ func (schematics *SchematicsV1) ListInventoriesWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_inventories", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecordList)
+	response, err = schematics.invoke(ctx, "ListInventories", listInventoriesOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_inventories", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecordList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4603,7 +4754,7 @@ func (schematics *SchematicsV1) CreateInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateInventory")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateInventory", createInventoryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4641,21 +4792,24 @@ func (schematics *SchematicsV1) CreateInventoryWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_inventory", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+	response, err = schematics.invoke(ctx, "CreateInventory", createInventoryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_inventory", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4711,7 +4865,7 @@ func (schematics *SchematicsV1) GetInventoryWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetInventory")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetInventory", getInventoryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4727,21 +4881,24 @@ func (schematics *SchematicsV1) GetInventoryWithContext(ctx context.Context, get
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_inventory", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+	response, err = schematics.invoke(ctx, "GetInventory", getInventoryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_inventory", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4797,7 +4954,7 @@ func (schematics *SchematicsV1) ReplaceInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceInventory")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ReplaceInventory", replaceInventoryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4835,21 +4992,24 @@ func (schematics *SchematicsV1) ReplaceInventoryWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "replace_inventory", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+	response, err = schematics.invoke(ctx, "ReplaceInventory", replaceInventoryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "replace_inventory", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalInventoryResourceRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -4905,7 +5065,7 @@ func (schematics *SchematicsV1) DeleteInventoryWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteInventory")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteInventory", deleteInventoryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4922,12 +5082,15 @@ func (schematics *SchematicsV1) DeleteInventoryWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_inventory", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteInventory", deleteInventoryOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_inventory", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -4970,7 +5133,7 @@ func (schematics *SchematicsV1) ListResourceQueryWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListResourceQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListResourceQuery", listResourceQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4995,21 +5158,24 @@ func (schematics *SchematicsV1) ListResourceQueryWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_resource_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecordList)
+	response, err = schematics.invoke(ctx, "ListResourceQuery", listResourceQueryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_resource_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecordList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5061,7 +5227,7 @@ func (schematics *SchematicsV1) CreateResourceQueryWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateResourceQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateResourceQuery", createResourceQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5090,21 +5256,24 @@ func (schematics *SchematicsV1) CreateResourceQueryWithContext(ctx context.Conte
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_resource_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+	response, err = schematics.invoke(ctx, "CreateResourceQuery", createResourceQueryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_resource_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5155,7 +5324,7 @@ func (schematics *SchematicsV1) GetResourcesQueryWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetResourcesQuery", getResourcesQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5167,21 +5336,24 @@ func (schematics *SchematicsV1) GetResourcesQueryWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_resources_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+	response, err = schematics.invoke(ctx, "GetResourcesQuery", getResourcesQueryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_resources_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5235,7 +5407,7 @@ func (schematics *SchematicsV1) ReplaceResourcesQueryWithContext(ctx context.Con
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ReplaceResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ReplaceResourcesQuery", replaceResourcesQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5264,21 +5436,24 @@ func (schematics *SchematicsV1) ReplaceResourcesQueryWithContext(ctx context.Con
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "replace_resources_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+	response, err = schematics.invoke(ctx, "ReplaceResourcesQuery", replaceResourcesQueryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "replace_resources_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5321,7 +5496,7 @@ func (schematics *SchematicsV1) ExecuteResourceQueryWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ExecuteResourceQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ExecuteResourceQuery", executeResourceQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5333,21 +5508,24 @@ func (schematics *SchematicsV1) ExecuteResourceQueryWithContext(ctx context.Cont
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "execute_resource_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryResponseRecord)
+	response, err = schematics.invoke(ctx, "ExecuteResourceQuery", executeResourceQueryOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "execute_resource_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResourceQueryResponseRecord)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5399,7 +5577,7 @@ func (schematics *SchematicsV1) DeleteResourcesQueryWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteResourcesQuery")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteResourcesQuery", deleteResourcesQueryOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5416,12 +5594,15 @@ func (schematics *SchematicsV1) DeleteResourcesQueryWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_resources_query", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteResourcesQuery", deleteResourcesQueryOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_resources_query", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -5464,7 +5645,7 @@ func (schematics *SchematicsV1) ListAgentWithContext(ctx context.Context, listAg
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListAgent")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListAgent", listAgentOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5489,21 +5670,24 @@ func (schematics *SchematicsV1) ListAgentWithContext(ctx context.Context, listAg
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_agent", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentList)
+	response, err = schematics.invoke(ctx, "ListAgent", listAgentOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_agent", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5551,7 +5735,7 @@ func (schematics *SchematicsV1) RegisterAgentWithContext(ctx context.Context, re
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "RegisterAgent")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "RegisterAgent", registerAgentOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5595,21 +5779,24 @@ func (schematics *SchematicsV1) RegisterAgentWithContext(ctx context.Context, re
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "register_agent", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+	response, err = schematics.invoke(ctx, "RegisterAgent", registerAgentOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "register_agent", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5661,7 +5848,7 @@ func (schematics *SchematicsV1) GetAgentWithContext(ctx context.Context, getAgen
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgent")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetAgent", getAgentOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5677,21 +5864,24 @@ func (schematics *SchematicsV1) GetAgentWithContext(ctx context.Context, getAgen
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_agent", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+	response, err = schematics.invoke(ctx, "GetAgent", getAgentOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_agent", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5743,7 +5933,7 @@ func (schematics *SchematicsV1) DeleteAgentWithContext(ctx context.Context, dele
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAgent")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteAgent", deleteAgentOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5754,12 +5944,15 @@ func (schematics *SchematicsV1) DeleteAgentWithContext(ctx context.Context, dele
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_agent", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteAgent", deleteAgentOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_agent", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -5811,7 +6004,7 @@ func (schematics *SchematicsV1) UpdateAgentRegistrationWithContext(ctx context.C
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAgentRegistration")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateAgentRegistration", updateAgentRegistrationOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5855,21 +6048,24 @@ func (schematics *SchematicsV1) UpdateAgentRegistrationWithContext(ctx context.C
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_agent_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+	response, err = schematics.invoke(ctx, "UpdateAgentRegistration", updateAgentRegistrationOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_agent_registration", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgent)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -5915,7 +6111,7 @@ func (schematics *SchematicsV1) ListAgentDataWithContext(ctx context.Context, li
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListAgentData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListAgentData", listAgentDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5940,21 +6136,24 @@ func (schematics *SchematicsV1) ListAgentDataWithContext(ctx context.Context, li
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_agent_data", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDataList)
+	response, err = schematics.invoke(ctx, "ListAgentData", listAgentDataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_agent_data", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDataList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6013,7 +6212,7 @@ func (schematics *SchematicsV1) CreateAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreateAgentData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreateAgentData", createAgentDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6069,21 +6268,24 @@ func (schematics *SchematicsV1) CreateAgentDataWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_agent_data", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+	response, err = schematics.invoke(ctx, "CreateAgentData", createAgentDataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_agent_data", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6137,7 +6339,7 @@ func (schematics *SchematicsV1) GetAgentDataWithContext(ctx context.Context, get
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgentData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetAgentData", getAgentDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6153,21 +6355,24 @@ func (schematics *SchematicsV1) GetAgentDataWithContext(ctx context.Context, get
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_agent_data", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+	response, err = schematics.invoke(ctx, "GetAgentData", getAgentDataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_agent_data", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6220,7 +6425,7 @@ func (schematics *SchematicsV1) UpdateAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateAgentData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateAgentData", updateAgentDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6279,21 +6484,24 @@ func (schematics *SchematicsV1) UpdateAgentDataWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_agent_data", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+	response, err = schematics.invoke(ctx, "UpdateAgentData", updateAgentDataOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_agent_data", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentData)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6346,7 +6554,7 @@ func (schematics *SchematicsV1) DeleteAgentDataWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAgentData")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteAgentData", deleteAgentDataOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6361,12 +6569,15 @@ func (schematics *SchematicsV1) DeleteAgentDataWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_agent_data", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteAgentData", deleteAgentDataOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_agent_data", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -6407,7 +6618,7 @@ func (schematics *SchematicsV1) GetAgentVersionsWithContext(ctx context.Context,
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetAgentVersions")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetAgentVersions", getAgentVersionsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6419,21 +6630,24 @@ func (schematics *SchematicsV1) GetAgentVersionsWithContext(ctx context.Context,
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_agent_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentVersions)
+	response, err = schematics.invoke(ctx, "GetAgentVersions", getAgentVersionsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_agent_versions", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentVersions)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6484,7 +6698,7 @@ func (schematics *SchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, g
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetPrsAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetPrsAgentJob", getPrsAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6496,21 +6710,24 @@ func (schematics *SchematicsV1) GetPrsAgentJobWithContext(ctx context.Context, g
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_prs_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentPRSJob)
+	response, err = schematics.invoke(ctx, "GetPrsAgentJob", getPrsAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_prs_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentPRSJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6558,7 +6775,7 @@ func (schematics *SchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsA
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "PrsAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "PrsAgentJob", prsAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6574,21 +6791,24 @@ func (schematics *SchematicsV1) PrsAgentJobWithContext(ctx context.Context, prsA
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "prs_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentPRSJob)
+	response, err = schematics.invoke(ctx, "PrsAgentJob", prsAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "prs_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentPRSJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6637,7 +6857,7 @@ func (schematics *SchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Co
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetHealthCheckAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetHealthCheckAgentJob", getHealthCheckAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6649,21 +6869,24 @@ func (schematics *SchematicsV1) GetHealthCheckAgentJobWithContext(ctx context.Co
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_health_check_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentHealthJob)
+	response, err = schematics.invoke(ctx, "GetHealthCheckAgentJob", getHealthCheckAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_health_check_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentHealthJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6710,7 +6933,7 @@ func (schematics *SchematicsV1) HealthCheckAgentJobWithContext(ctx context.Conte
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "HealthCheckAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "HealthCheckAgentJob", healthCheckAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6726,21 +6949,24 @@ func (schematics *SchematicsV1) HealthCheckAgentJobWithContext(ctx context.Conte
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "health_check_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentHealthJob)
+	response, err = schematics.invoke(ctx, "HealthCheckAgentJob", healthCheckAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "health_check_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentHealthJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6788,7 +7014,7 @@ func (schematics *SchematicsV1) GetDeployAgentJobWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetDeployAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetDeployAgentJob", getDeployAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6800,21 +7026,24 @@ func (schematics *SchematicsV1) GetDeployAgentJobWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_deploy_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDeployJob)
+	response, err = schematics.invoke(ctx, "GetDeployAgentJob", getDeployAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_deploy_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDeployJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6861,7 +7090,7 @@ func (schematics *SchematicsV1) DeployAgentJobWithContext(ctx context.Context, d
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeployAgentJob")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeployAgentJob", deployAgentJobOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6877,21 +7106,24 @@ func (schematics *SchematicsV1) DeployAgentJobWithContext(ctx context.Context, d
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "deploy_agent_job", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDeployJob)
+	response, err = schematics.invoke(ctx, "DeployAgentJob", deployAgentJobOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "deploy_agent_job", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalAgentDeployJob)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -6934,7 +7166,7 @@ func (schematics *SchematicsV1) DeleteAgentResourcesWithContext(ctx context.Cont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeleteAgentResources")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeleteAgentResources", deleteAgentResourcesOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -6948,12 +7180,15 @@ func (schematics *SchematicsV1) DeleteAgentResourcesWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_agent_resources", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeleteAgentResources", deleteAgentResourcesOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_agent_resources", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -7000,7 +7235,7 @@ func (schematics *SchematicsV1) GetKmsSettingsWithContext(ctx context.Context, g
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetKmsSettings")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetKmsSettings", getKmsSettingsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7014,21 +7249,24 @@ func (schematics *SchematicsV1) GetKmsSettingsWithContext(ctx context.Context, g
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_kms_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSSettings)
+	response, err = schematics.invoke(ctx, "GetKmsSettings", getKmsSettingsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_kms_settings", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSSettings)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7075,7 +7313,7 @@ func (schematics *SchematicsV1) UpdateKmsSettingsWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdateKmsSettings")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdateKmsSettings", updateKmsSettingsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7110,21 +7348,24 @@ func (schematics *SchematicsV1) UpdateKmsSettingsWithContext(ctx context.Context
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_kms_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSSettings)
+	response, err = schematics.invoke(ctx, "UpdateKmsSettings", updateKmsSettingsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_kms_settings", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSSettings)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7171,7 +7412,7 @@ func (schematics *SchematicsV1) ListKmsWithContext(ctx context.Context, listKmsO
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListKms")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListKms", listKmsOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7195,21 +7436,24 @@ func (schematics *SchematicsV1) ListKmsWithContext(ctx context.Context, listKmsO
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_kms", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSDiscovery)
+	response, err = schematics.invoke(ctx, "ListKms", listKmsOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_kms", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalKMSDiscovery)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7252,7 +7496,7 @@ func (schematics *SchematicsV1) ListPolicyWithContext(ctx context.Context, listP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "ListPolicy")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "ListPolicy", listPolicyOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7274,21 +7518,24 @@ func (schematics *SchematicsV1) ListPolicyWithContext(ctx context.Context, listP
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "list_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicyList)
+	response, err = schematics.invoke(ctx, "ListPolicy", listPolicyOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "list_policy", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicyList)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7337,7 +7584,7 @@ func (schematics *SchematicsV1) CreatePolicyWithContext(ctx context.Context, cre
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "CreatePolicy")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "CreatePolicy", createPolicyOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7387,21 +7634,24 @@ func (schematics *SchematicsV1) CreatePolicyWithContext(ctx context.Context, cre
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "create_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+	response, err = schematics.invoke(ctx, "CreatePolicy", createPolicyOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "create_policy", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7454,7 +7704,7 @@ func (schematics *SchematicsV1) GetPolicyWithContext(ctx context.Context, getPol
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "GetPolicy")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetPolicy", getPolicyOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7470,21 +7720,24 @@ func (schematics *SchematicsV1) GetPolicyWithContext(ctx context.Context, getPol
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "get_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+	response, err = schematics.invoke(ctx, "GetPolicy", getPolicyOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "get_policy", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
@@ -7537,7 +7790,7 @@ func (schematics *SchematicsV1) DeletePolicyWithContext(ctx context.Context, del
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "DeletePolicy")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "DeletePolicy", deletePolicyOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7548,12 +7801,15 @@ func (schematics *SchematicsV1) DeletePolicyWithContext(ctx context.Context, del
 		return
 	}
 
-	response, err = schematics.Service.Request(request, nil)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "delete_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+	response, err = schematics.invoke(ctx, "DeletePolicy", deletePolicyOptions, request, nil, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, nil)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "delete_policy", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
 		return
-	}
+	})
 
 	return
 }
@@ -7608,7 +7864,7 @@ func (schematics *SchematicsV1) UpdatePolicyWithContext(ctx context.Context, upd
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("schematics", "V1", "UpdatePolicy")
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "UpdatePolicy", updatePolicyOptions.Headers)
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -7658,21 +7914,24 @@ func (schematics *SchematicsV1) UpdatePolicyWithContext(ctx context.Context, upd
 		return
 	}
 
-	var rawResponse map[string]json.RawMessage
-	response, err = schematics.Service.Request(request, &rawResponse)
-	if err != nil {
-		core.EnrichHTTPProblem(err, "update_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
-		return
-	}
-	if rawResponse != nil {
-		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+	response, err = schematics.invoke(ctx, "UpdatePolicy", updatePolicyOptions, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
 		if err != nil {
-			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			core.EnrichHTTPProblem(err, "update_policy", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
 			return
 		}
-		response.Result = result
-	}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalPolicy)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
 
 	return
 }
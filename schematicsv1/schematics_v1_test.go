@@ -163,6 +163,14 @@ var _ = Describe(`SchematicsV1`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = schematicsv1.GetServiceURLForRegion("us-south")
+			Expect(url).To(Equal("https://us-south.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
+
+			url, err = schematicsv1.GetServiceURLForRegion("private.eu-de")
+			Expect(url).To(Equal("https://private-eu-de.schematics.cloud.ibm.com"))
+			Expect(err).To(BeNil())
 		})
 	})
 	Describe(`ListSchematicsLocation(listSchematicsLocationOptions *ListSchematicsLocationOptions) - Operation response error`, func() {
@@ -0,0 +1,489 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// TemplateArchiveMaxSize is the largest template archive that Schematics accepts, in bytes.
+const TemplateArchiveMaxSize = 10 * 1024 * 1024
+
+// TemplateArchiveContentType is the content type of the archives written by TemplateArchive.
+const TemplateArchiveContentType = "application/gzip"
+
+// templateArchiveIgnoreFiles are the files in the root of a template directory that hold exclude rules.
+var templateArchiveIgnoreFiles = []string{".gitignore", ".terraformignore"}
+
+// templateArchiveDefaultExcludes are the exclude rules that apply to every template archive: Git metadata,
+// Terraform's working directory and local state files.
+var templateArchiveDefaultExcludes = []string{
+	".git/",
+	".terraform/",
+	"*.tfstate",
+	"*.tfstate.*",
+	".terraform.tfstate.lock.info",
+}
+
+// TemplateArchive : a builder of reproducible tar.gz archives of a local template directory, as uploaded with
+// TemplateRepoUpload and UploadTemplateTarAction.
+//
+// Files are excluded by the default rules (.git, .terraform and state files), by the rules in the .gitignore and
+// .terraformignore files in the root of the directory, and by the rules added with Exclude. Rules use the
+// .gitignore syntax, including negation with "!", directory-only rules with a trailing "/" and "**" wildcards.
+// Symbolic links and other special files are left out. Files are stored in lexical order with normalized owners,
+// modes (0644, or 0755 for executables) and timestamps, so the same content always produces the same archive.
+type TemplateArchive struct {
+	dir      string
+	excludes []string
+	maxSize  int64
+	modTime  time.Time
+
+	size   int64
+	sha256 string
+}
+
+// NewTemplateArchive : constructs a TemplateArchive of the specified directory, limited to TemplateArchiveMaxSize.
+func NewTemplateArchive(dir string) *TemplateArchive {
+	return &TemplateArchive{
+		dir:     dir,
+		maxSize: TemplateArchiveMaxSize,
+		modTime: time.Unix(0, 0).UTC(),
+	}
+}
+
+// Exclude adds exclude rules, in the .gitignore syntax, that apply after the rules of the ignore files.
+func (archive *TemplateArchive) Exclude(patterns ...string) *TemplateArchive {
+	archive.excludes = append(archive.excludes, patterns...)
+	return archive
+}
+
+// SetMaxSize sets the largest archive that can be written, in bytes. Zero removes the limit.
+func (archive *TemplateArchive) SetMaxSize(maxSize int64) *TemplateArchive {
+	archive.maxSize = maxSize
+	return archive
+}
+
+// SetModTime sets the modification time stored for every file (the Unix epoch by default).
+func (archive *TemplateArchive) SetModTime(modTime time.Time) *TemplateArchive {
+	archive.modTime = modTime
+	return archive
+}
+
+// Size returns the size in bytes of the archive last written.
+func (archive *TemplateArchive) Size() int64 {
+	return archive.size
+}
+
+// SHA256 returns the hex-encoded SHA256 digest of the archive last written.
+func (archive *TemplateArchive) SHA256() string {
+	return archive.sha256
+}
+
+// Files returns the slash-separated paths, relative to the directory, of the files that the archive contains.
+func (archive *TemplateArchive) Files() (files []string, err error) {
+	err = archive.walk(func(name string, path string, info fs.FileInfo) error {
+		files = append(files, name)
+		return nil
+	})
+	return
+}
+
+// WriteTo writes the archive to writer and records its size and SHA256 digest. It fails without writing further
+// once the archive exceeds the size limit.
+func (archive *TemplateArchive) WriteTo(writer io.Writer) (int64, error) {
+	archive.size, archive.sha256 = 0, ""
+	counter := &templateArchiveCounter{writer: writer, hash: sha256.New(), maxSize: archive.maxSize}
+	gzipWriter := gzip.NewWriter(counter)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := archive.walk(func(name string, path string, info fs.FileInfo) error {
+		mode := int64(0644)
+		if info.Mode()&0111 != 0 {
+			mode = 0755
+		}
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     mode,
+			Size:     info.Size(),
+			ModTime:  archive.modTime,
+			Format:   tar.FormatPAX,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.CopyN(tarWriter, file, info.Size())
+		return err
+	})
+	if err == nil {
+		err = tarWriter.Close()
+	}
+	if err == nil {
+		err = gzipWriter.Close()
+	}
+	if err != nil {
+		if counter.err != nil {
+			err = counter.err
+		}
+		if _, ok := err.(*core.SDKProblem); !ok {
+			err = core.SDKErrorf(err, "", "template-archive-write-error", common.GetComponentInfo())
+		}
+		return counter.size, err
+	}
+	archive.size, archive.sha256 = counter.size, hex.EncodeToString(counter.hash.Sum(nil))
+	return counter.size, nil
+}
+
+// Open returns a reader of the archive, written on demand by a goroutine. Errors writing the archive are returned
+// by Read. Size and SHA256 are set once the reader has returned io.EOF.
+func (archive *TemplateArchive) Open() io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		_, err := archive.WriteTo(writer)
+		writer.CloseWithError(err)
+	}()
+	return reader
+}
+
+// walk calls visit with the slash-separated relative path, the path and the file info of each file of the archive,
+// in lexical order.
+func (archive *TemplateArchive) walk(visit func(name string, path string, info fs.FileInfo) error) error {
+	rules := newIgnoreRules(templateArchiveDefaultExcludes)
+	for _, ignoreFile := range templateArchiveIgnoreFiles {
+		patterns, err := readIgnoreFile(filepath.Join(archive.dir, ignoreFile))
+		if err != nil {
+			return core.SDKErrorf(err, "", "template-archive-read-error", common.GetComponentInfo())
+		}
+		rules = append(rules, newIgnoreRules(patterns)...)
+	}
+	rules = append(rules, newIgnoreRules(archive.excludes)...)
+
+	err := filepath.WalkDir(archive.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(archive.dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if rules.excludes(name, entry.IsDir()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return visit(name, path, info)
+	})
+	if err != nil {
+		if _, ok := err.(*core.SDKProblem); !ok {
+			err = core.SDKErrorf(err, "", "template-archive-read-error", common.GetComponentInfo())
+		}
+	}
+	return err
+}
+
+// UploadWorkspaceTemplateFromDir uploads an archive of a local template directory, built by TemplateArchive with
+// its default rules, as the template of a workspace. Use TemplateArchive.Open with TemplateRepoUploadWithProgress
+// to upload an archive with other rules or to report the progress of the upload.
+func (schematics *SchematicsV1) UploadWorkspaceTemplateFromDir(ctx context.Context, wID string, tID string, dir string) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	templateRepoUploadOptions := schematics.NewTemplateRepoUploadOptions(wID, tID)
+	templateRepoUploadOptions.SetFileContentType(TemplateArchiveContentType)
+	err = uploadTemplateArchive(NewTemplateArchive(dir), func(file io.ReadCloser) error {
+		templateRepoUploadOptions.SetFile(file)
+		var uploadErr error
+		result, response, uploadErr = schematics.TemplateRepoUploadWithProgress(ctx, templateRepoUploadOptions, nil)
+		return uploadErr
+	})
+	return
+}
+
+// UploadActionTemplateFromDir uploads an archive of a local template directory, built by TemplateArchive with its
+// default rules, as the template of an action. Use TemplateArchive.Open with UploadTemplateTarActionWithProgress
+// to upload an archive with other rules or to report the progress of the upload.
+func (schematics *SchematicsV1) UploadActionTemplateFromDir(ctx context.Context, actionID string, dir string) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	uploadTemplateTarActionOptions := schematics.NewUploadTemplateTarActionOptions(actionID)
+	uploadTemplateTarActionOptions.SetFileContentType(TemplateArchiveContentType)
+	err = uploadTemplateArchive(NewTemplateArchive(dir), func(file io.ReadCloser) error {
+		uploadTemplateTarActionOptions.SetFile(file)
+		var uploadErr error
+		result, response, uploadErr = schematics.UploadTemplateTarActionWithProgress(ctx, uploadTemplateTarActionOptions, nil)
+		return uploadErr
+	})
+	return
+}
+
+// uploadTemplateArchive streams archive to upload. If writing the archive fails other than because the upload
+// stopped reading it, its error is returned instead of the upload's error.
+func uploadTemplateArchive(archive *TemplateArchive, upload func(file io.ReadCloser) error) error {
+	reader, writer := io.Pipe()
+	writeErrs := make(chan error, 1)
+	go func() {
+		_, err := archive.WriteTo(writer)
+		writer.CloseWithError(err)
+		writeErrs <- err
+	}()
+	err := upload(reader)
+	reader.Close()
+	if writeErr := <-writeErrs; writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe) {
+		return writeErr
+	}
+	return err
+}
+
+// templateArchiveCounter counts and hashes the bytes written to an archive and enforces its size limit.
+type templateArchiveCounter struct {
+	writer  io.Writer
+	hash    hash.Hash
+	maxSize int64
+	size    int64
+	err     error
+}
+
+func (counter *templateArchiveCounter) Write(p []byte) (int, error) {
+	if counter.maxSize > 0 && counter.size+int64(len(p)) > counter.maxSize {
+		counter.err = core.SDKErrorf(nil, fmt.Sprintf("the template archive exceeds the size limit of %d bytes", counter.maxSize), "template-archive-too-large", common.GetComponentInfo())
+		return 0, counter.err
+	}
+	n, err := counter.writer.Write(p)
+	counter.hash.Write(p[:n])
+	counter.size += int64(n)
+	return n, err
+}
+
+// ignoreRule : an exclude rule in the .gitignore syntax.
+type ignoreRule struct {
+	negate  bool
+	dirOnly bool
+	pattern *regexp.Regexp
+}
+
+// ignoreRules : exclude rules in order of precedence; the last matching rule decides.
+type ignoreRules []ignoreRule
+
+func newIgnoreRules(patterns []string) (rules ignoreRules) {
+	for _, pattern := range patterns {
+		pattern = strings.TrimRight(pattern, " \t\r")
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		rule := ignoreRule{}
+		if strings.HasPrefix(pattern, "!") {
+			rule.negate = true
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			rule.dirOnly = true
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		anchored := strings.Contains(pattern, "/")
+		pattern = strings.TrimPrefix(pattern, "/")
+		if pattern == "" {
+			continue
+		}
+		// Like git, skip patterns that are malformed: they never match anything.
+		expression, ok := globToRegexp(pattern)
+		if !ok {
+			continue
+		}
+		if !anchored {
+			expression = "(.*/)?" + expression
+		}
+		compiled, err := regexp.Compile("^" + expression + "$")
+		if err != nil {
+			continue
+		}
+		rule.pattern = compiled
+		rules = append(rules, rule)
+	}
+	return
+}
+
+// excludes reports whether the slash-separated relative path name is excluded by the rules.
+func (rules ignoreRules) excludes(name string, isDir bool) (excluded bool) {
+	for _, rule := range rules {
+		if (!rule.dirOnly || isDir) && rule.pattern.MatchString(name) {
+			excluded = !rule.negate
+		}
+	}
+	return
+}
+
+// globToRegexp translates a .gitignore pattern, without its leading and trailing slashes, to a regular expression.
+// It returns false for a pattern that can never match, such as one with an unterminated bracket expression.
+func globToRegexp(pattern string) (string, bool) {
+	var expression strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expression.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expression.WriteString(".*")
+			i++
+		case c == '*':
+			expression.WriteString("[^/]*")
+		case c == '?':
+			expression.WriteString("[^/]")
+		case c == '[':
+			class, length, ok := bracketToRegexp(pattern[i:])
+			if !ok {
+				return "", false
+			}
+			expression.WriteString(class)
+			i += length - 1
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			expression.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return expression.String(), true
+}
+
+// ignorePatternClasses : the character classes that bracket expressions of .gitignore patterns support.
+var ignorePatternClasses = map[string]bool{
+	"alnum": true, "alpha": true, "blank": true, "cntrl": true, "digit": true, "graph": true,
+	"lower": true, "print": true, "punct": true, "space": true, "upper": true, "xdigit": true,
+}
+
+// bracketToRegexp translates the bracket expression at the start of a .gitignore pattern to a regular expression
+// and returns the length of the bracket expression in the pattern. Like git, a bracket expression never matches a
+// slash, and it cannot match anything if it is unterminated, uses an unknown character class or only has empty
+// ranges.
+func bracketToRegexp(pattern string) (expression string, length int, ok bool) {
+	var class strings.Builder
+	negate := false
+	i := 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
+	}
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			if negate {
+				return "[^/" + class.String() + "]", i + 1, true
+			}
+			if class.Len() == 0 {
+				return "", 0, false
+			}
+			return "[" + class.String() + "]", i + 1, true
+		}
+		if strings.HasPrefix(pattern[i:], "[:") {
+			end := strings.Index(pattern[i+2:], ":]")
+			if end < 0 || !ignorePatternClasses[pattern[i+2:i+2+end]] {
+				return "", 0, false
+			}
+			class.WriteString(pattern[i : i+2+end+2])
+			i += 2 + end + 2
+			continue
+		}
+		low, size, ok := bracketCharacter(pattern[i:])
+		if !ok {
+			return "", 0, false
+		}
+		i += size
+		high := low
+		if i+1 < len(pattern) && pattern[i] == '-' && pattern[i+1] != ']' {
+			if high, size, ok = bracketCharacter(pattern[i+1:]); !ok {
+				return "", 0, false
+			}
+			i += 1 + size
+		}
+		// A range whose end precedes its start matches nothing.
+		if low > high {
+			continue
+		}
+		class.WriteString(quoteClassCharacter(low))
+		if high != low {
+			class.WriteString("-" + quoteClassCharacter(high))
+		}
+	}
+	return "", 0, false
+}
+
+// bracketCharacter returns the, possibly escaped, character at the start of a bracket expression's pattern.
+func bracketCharacter(pattern string) (character rune, size int, ok bool) {
+	if pattern[0] == '\\' {
+		if len(pattern) == 1 {
+			return 0, 0, false
+		}
+		character, size = utf8.DecodeRuneInString(pattern[1:])
+		return character, size + 1, true
+	}
+	character, size = utf8.DecodeRuneInString(pattern)
+	return character, size, true
+}
+
+// quoteClassCharacter returns a character for use in a character class of a regular expression.
+func quoteClassCharacter(character rune) string {
+	if character < utf8.RuneSelf && !unicode.IsLetter(character) && !unicode.IsDigit(character) {
+		return "\\" + string(character)
+	}
+	return string(character)
+}
+
+// readIgnoreFile returns the lines of an ignore file, or nothing if the file does not exist.
+func readIgnoreFile(path string) (patterns []string, err error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		patterns = append(patterns, scanner.Text())
+	}
+	return patterns, scanner.Err()
+}
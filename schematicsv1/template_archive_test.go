@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// writeFiles creates files, keyed by slash-separated path, under dir.
+func writeFiles(dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+}
+
+// readTarGz returns the headers and contents of the files of a tar.gz archive, keyed by name.
+func readTarGz(data []byte) (map[string]*tar.Header, map[string]string) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).To(BeNil())
+	tarReader := tar.NewReader(gzipReader)
+	headers := map[string]*tar.Header{}
+	contents := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return headers, contents
+		}
+		Expect(err).To(BeNil())
+		content, err := io.ReadAll(tarReader)
+		Expect(err).To(BeNil())
+		headers[header.Name] = header
+		contents[header.Name] = string(content)
+	}
+}
+
+var _ = Describe(`SchematicsV1 template archives`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "template-archive")
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{
+			"main.tf":                       "resource {}",
+			"variables.tf":                  "variable {}",
+			"modules/vpc/main.tf":           "module {}",
+			"modules/vpc/notes.txt":         "notes",
+			"modules/vpc/keep.txt":          "keep",
+			"terraform.tfstate":             "{}",
+			"terraform.tfstate.backup":      "{}",
+			".terraform/providers/ibm":      "binary",
+			".git/config":                   "[core]",
+			"build/output.bin":              "out",
+			"secrets.auto.tfvars":           "key = 1",
+			".gitignore":                    "build/\n# comment\n*.txt\n!keep.txt\n",
+			".terraformignore":              "/secrets.auto.tfvars\n",
+			"scripts/setup.sh":              "#!/bin/sh",
+			"docs/images/diagram.png":       "png",
+			"docs/images/nested/figure.png": "png",
+		})
+		Expect(os.Chmod(filepath.Join(dir, "scripts", "setup.sh"), 0700)).To(Succeed())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Apply the default, ignore file and additional exclude rules`, func() {
+		files, err := schematicsv1.NewTemplateArchive(dir).Exclude("docs/**/*.png").Files()
+		Expect(err).To(BeNil())
+		Expect(files).To(Equal([]string{
+			".gitignore",
+			".terraformignore",
+			"main.tf",
+			"modules/vpc/keep.txt",
+			"modules/vpc/main.tf",
+			"scripts/setup.sh",
+			"variables.tf",
+		}))
+	})
+	It(`Translate bracket expressions and skip malformed ignore patterns`, func() {
+		writeFiles(dir, map[string]string{
+			".gitignore": "[z-a]\n[unterminated\n[[:unknown:]]\n[[:alpha:]]*.log\n[!m]ain.tf\n[]x].cfg\n",
+			"a.log":      "log",
+			"1.log":      "log",
+			"gain.tf":    "gain",
+			"].cfg":      "cfg",
+			"y.cfg":      "cfg",
+			"z":          "z",
+		})
+		archive := schematicsv1.NewTemplateArchive(dir)
+		files, err := archive.Files()
+		Expect(err).To(BeNil())
+		Expect(files).To(ContainElements("1.log", "main.tf", "modules/vpc/notes.txt", "y.cfg", "z"))
+		Expect(files).ToNot(ContainElement("a.log"))
+		Expect(files).ToNot(ContainElement("gain.tf"))
+		Expect(files).ToNot(ContainElement("].cfg"))
+		_, err = archive.WriteTo(io.Discard)
+		Expect(err).To(BeNil())
+	})
+	It(`Write reproducible archives with normalized headers and a digest`, func() {
+		archive := schematicsv1.NewTemplateArchive(dir)
+		first := &bytes.Buffer{}
+		size, err := archive.WriteTo(first)
+		Expect(err).To(BeNil())
+		Expect(size).To(Equal(int64(first.Len())))
+		Expect(archive.Size()).To(Equal(size))
+		digest := sha256.Sum256(first.Bytes())
+		Expect(archive.SHA256()).To(Equal(hex.EncodeToString(digest[:])))
+
+		later := time.Now().Add(time.Hour)
+		Expect(os.Chtimes(filepath.Join(dir, "main.tf"), later, later)).To(Succeed())
+		second, err := io.ReadAll(archive.Open())
+		Expect(err).To(BeNil())
+		Expect(second).To(Equal(first.Bytes()))
+
+		headers, contents := readTarGz(second)
+		Expect(contents["modules/vpc/main.tf"]).To(Equal("module {}"))
+		Expect(headers["main.tf"].Mode).To(Equal(int64(0644)))
+		Expect(headers["scripts/setup.sh"].Mode).To(Equal(int64(0755)))
+		Expect(headers["main.tf"].ModTime.Unix()).To(Equal(int64(0)))
+		Expect(headers["main.tf"].Uid).To(Equal(0))
+		Expect(headers).ToNot(HaveKey("terraform.tfstate"))
+	})
+	It(`Enforce the size limit`, func() {
+		random := make([]byte, 64*1024)
+		_, err := rand.Read(random)
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{"data.bin": string(random)})
+
+		_, err = schematicsv1.NewTemplateArchive(dir).SetMaxSize(32 * 1024).WriteTo(io.Discard)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("exceeds the size limit of 32768 bytes"))
+		_, err = io.ReadAll(schematicsv1.NewTemplateArchive(dir).SetMaxSize(32 * 1024).Open())
+		Expect(err).ToNot(BeNil())
+		_, err = schematicsv1.NewTemplateArchive(dir).SetMaxSize(0).WriteTo(io.Discard)
+		Expect(err).To(BeNil())
+	})
+	It(`Fail for a missing directory`, func() {
+		_, err := schematicsv1.NewTemplateArchive(filepath.Join(dir, "missing")).WriteTo(io.Discard)
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`Upload templates from a directory`, func() {
+		var testServer *httptest.Server
+		var schematicsService *schematicsv1.SchematicsV1
+		var uploads map[string][]byte
+		var status int
+
+		BeforeEach(func() {
+			uploads = map[string][]byte{}
+			status = http.StatusOK
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				file, header, err := req.FormFile("file")
+				if err != nil {
+					res.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				Expect(header.Header.Get("Content-Type")).To(Equal(schematicsv1.TemplateArchiveContentType))
+				uploads[req.URL.Path], _ = io.ReadAll(file)
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(status)
+				fmt.Fprint(res, `{"has_received_file": true}`)
+			}))
+			var serviceErr error
+			schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Upload a workspace and an action template`, func() {
+			expected := &bytes.Buffer{}
+			_, err := schematicsv1.NewTemplateArchive(dir).WriteTo(expected)
+			Expect(err).To(BeNil())
+
+			result, _, err := schematicsService.UploadWorkspaceTemplateFromDir(context.Background(), "w1", "t1", dir)
+			Expect(err).To(BeNil())
+			Expect(*result.HasReceivedFile).To(BeTrue())
+			_, _, err = schematicsService.UploadActionTemplateFromDir(context.Background(), "a1", dir)
+			Expect(err).To(BeNil())
+
+			Expect(uploads).To(HaveLen(2))
+			Expect(uploads["/v1/workspaces/w1/template_data/t1/template_repo_upload"]).To(Equal(expected.Bytes()))
+			Expect(uploads["/v2/actions/a1/template_repo_upload"]).To(Equal(expected.Bytes()))
+		})
+		It(`Return the service error of a failed upload`, func() {
+			status = http.StatusBadRequest
+			_, response, err := schematicsService.UploadWorkspaceTemplateFromDir(context.Background(), "w1", "t1", dir)
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+		It(`Return the archive error of a directory that cannot be archived`, func() {
+			_, _, err := schematicsService.UploadWorkspaceTemplateFromDir(context.Background(), "w1", "t1", filepath.Join(dir, "missing"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("missing"))
+		})
+	})
+})
@@ -0,0 +1,412 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// templateFiles : the contents of the files of a template, keyed by slash-separated path.
+type templateFiles map[string][]byte
+
+// readTemplateDir reads the files of a template directory that a TemplateArchive of it would contain. Only the
+// contents of the files that match the filter are read; other files are listed without content.
+func readTemplateDir(dir string, filter func(name string) bool) (templateFiles, error) {
+	files := templateFiles{}
+	err := NewTemplateArchive(dir).walk(func(name string, path string, info fs.FileInfo) error {
+		if !filter(name) {
+			files[name] = nil
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		files[name] = data
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*core.SDKProblem); !ok {
+			err = core.SDKErrorf(err, "", "template-read-error", common.GetComponentInfo())
+		}
+		return nil, err
+	}
+	return files, nil
+}
+
+// readTemplateTar reads the files of a tar or gzipped tar template archive. Only the contents of the files that
+// match the filter are read; other files are listed without content.
+func readTemplateTar(reader io.Reader, filter func(name string) bool) (templateFiles, error) {
+	buffered := bufio.NewReader(reader)
+	if magic, _ := buffered.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, core.SDKErrorf(err, "the template archive is not a valid gzip file", "template-read-error", common.GetComponentInfo())
+		}
+		reader = gzipReader
+	} else {
+		reader = buffered
+	}
+
+	files := templateFiles{}
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, core.SDKErrorf(err, "the template archive is not a valid tar file", "template-read-error", common.GetComponentInfo())
+		}
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if !filter(name) {
+			files[name] = nil
+			continue
+		}
+		if files[name], err = io.ReadAll(tarReader); err != nil {
+			return nil, core.SDKErrorf(err, "", "template-read-error", common.GetComponentInfo())
+		}
+	}
+}
+
+// inFolder returns the names of the files directly within folder (the root if empty or "."), in lexical order.
+func (files templateFiles) inFolder(folder string, extensions ...string) (names []string) {
+	folder = strings.Trim(path.Clean("/"+folder), "/")
+	for name := range files {
+		if path.Dir(name) != folder && !(folder == "" && path.Dir(name) == ".") {
+			continue
+		}
+		for _, extension := range extensions {
+			if strings.HasSuffix(name, extension) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return
+}
+
+// hasFolder reports whether any file is within folder or its subfolders.
+func (files templateFiles) hasFolder(folder string) bool {
+	folder = strings.Trim(path.Clean("/"+folder), "/")
+	if folder == "" {
+		return len(files) > 0
+	}
+	for name := range files {
+		if strings.HasPrefix(name, folder+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// tfBlock : a block of a Terraform configuration file, such as a variable, provider or terraform block.
+// Attribute values are kept as their source expressions.
+type tfBlock struct {
+	Type       string
+	Labels     []string
+	Line       int
+	Attributes map[string]tfAttribute
+	Blocks     []*tfBlock
+}
+
+// tfAttribute : an attribute of a Terraform block and the line it starts on.
+type tfAttribute struct {
+	Expr string
+	Line int
+}
+
+// blocks returns the nested blocks of the specified type.
+func (block *tfBlock) blocks(blockType string) (blocks []*tfBlock) {
+	for _, nested := range block.Blocks {
+		if nested.Type == blockType {
+			blocks = append(blocks, nested)
+		}
+	}
+	return
+}
+
+// parseTerraformFile parses the structure of a Terraform configuration file written in the native syntax. It
+// reads blocks and attributes without evaluating expressions, which is sufficient to find declarations.
+func parseTerraformFile(src []byte) (*tfBlock, error) {
+	parser := &tfParser{src: src, line: 1}
+	body := &tfBlock{Line: 1, Attributes: map[string]tfAttribute{}}
+	if err := parser.parseBody(body, false); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// tfParser : a reader of the structure of the native Terraform syntax.
+type tfParser struct {
+	src  []byte
+	pos  int
+	line int
+}
+
+func (parser *tfParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", parser.line, fmt.Sprintf(format, args...))
+}
+
+func (parser *tfParser) peek() byte {
+	if parser.pos < len(parser.src) {
+		return parser.src[parser.pos]
+	}
+	return 0
+}
+
+func (parser *tfParser) next() byte {
+	c := parser.src[parser.pos]
+	parser.pos++
+	if c == '\n' {
+		parser.line++
+	}
+	return c
+}
+
+func (parser *tfParser) hasPrefix(prefix string) bool {
+	return bytes.HasPrefix(parser.src[parser.pos:], []byte(prefix))
+}
+
+// skipSpace skips spaces, comments and, if newlines is true, line breaks.
+func (parser *tfParser) skipSpace(newlines bool) {
+	for parser.pos < len(parser.src) {
+		switch c := parser.peek(); {
+		case c == ' ' || c == '\t' || c == '\r' || (newlines && c == '\n'):
+			parser.next()
+		case c == '#' || parser.hasPrefix("//"):
+			for parser.pos < len(parser.src) && parser.peek() != '\n' {
+				parser.next()
+			}
+		case parser.hasPrefix("/*"):
+			for parser.pos < len(parser.src) && !parser.hasPrefix("*/") {
+				parser.next()
+			}
+			if parser.pos < len(parser.src) {
+				parser.pos += 2
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (parser *tfParser) identifier() string {
+	start := parser.pos
+	for parser.pos < len(parser.src) && isIdentifierByte(parser.peek()) {
+		parser.next()
+	}
+	return string(parser.src[start:parser.pos])
+}
+
+// parseBody reads attributes and blocks into block until the end of the file or, if nested, a closing brace.
+func (parser *tfParser) parseBody(block *tfBlock, nested bool) error {
+	for {
+		parser.skipSpace(true)
+		if parser.pos >= len(parser.src) {
+			if nested {
+				return parser.errorf("unclosed block '%s'", block.Type)
+			}
+			return nil
+		}
+		if parser.peek() == '}' && nested {
+			parser.next()
+			return nil
+		}
+		line := parser.line
+		name := parser.identifier()
+		if name == "" {
+			return parser.errorf("unexpected character '%c'", parser.peek())
+		}
+		parser.skipSpace(false)
+		if parser.peek() == '=' && !parser.hasPrefix("==") {
+			parser.next()
+			expr, err := parser.expression()
+			if err != nil {
+				return err
+			}
+			block.Attributes[name] = tfAttribute{Expr: expr, Line: line}
+			continue
+		}
+
+		nestedBlock := &tfBlock{Type: name, Line: line, Attributes: map[string]tfAttribute{}}
+		for parser.peek() != '{' {
+			switch {
+			case parser.peek() == '"':
+				start := parser.pos
+				if err := parser.skipString(); err != nil {
+					return err
+				}
+				label, err := strconv.Unquote(string(parser.src[start:parser.pos]))
+				if err != nil {
+					return parser.errorf("invalid label of block '%s'", name)
+				}
+				nestedBlock.Labels = append(nestedBlock.Labels, label)
+			case isIdentifierByte(parser.peek()):
+				nestedBlock.Labels = append(nestedBlock.Labels, parser.identifier())
+			default:
+				return parser.errorf("expected '=' or '{' after '%s'", name)
+			}
+			parser.skipSpace(false)
+		}
+		parser.next()
+		if err := parser.parseBody(nestedBlock, true); err != nil {
+			return err
+		}
+		block.Blocks = append(block.Blocks, nestedBlock)
+	}
+}
+
+// expression reads the source of an attribute value, which ends at a line break outside brackets.
+func (parser *tfParser) expression() (string, error) {
+	parser.skipSpace(false)
+	start := parser.pos
+	depth := 0
+	for parser.pos < len(parser.src) {
+		switch c := parser.peek(); {
+		case c == '"':
+			if err := parser.skipString(); err != nil {
+				return "", err
+			}
+			continue
+		case parser.hasPrefix("<<"):
+			if err := parser.skipHeredoc(); err != nil {
+				return "", err
+			}
+			continue
+		case c == '#' || parser.hasPrefix("//") || parser.hasPrefix("/*"):
+			end := parser.pos
+			parser.skipSpace(false)
+			if depth == 0 && (parser.peek() == '\n' || parser.pos >= len(parser.src)) {
+				return strings.TrimSpace(string(parser.src[start:end])), nil
+			}
+			continue
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth == 0 {
+				return strings.TrimSpace(string(parser.src[start:parser.pos])), nil
+			}
+			depth--
+		case c == '\n' && depth == 0:
+			return strings.TrimSpace(string(parser.src[start:parser.pos])), nil
+		}
+		parser.next()
+	}
+	if depth > 0 {
+		return "", parser.errorf("unclosed bracket")
+	}
+	return strings.TrimSpace(string(parser.src[start:parser.pos])), nil
+}
+
+// skipString skips a quoted string, including the expressions of its template interpolations.
+func (parser *tfParser) skipString() error {
+	parser.next()
+	for parser.pos < len(parser.src) {
+		switch c := parser.next(); {
+		case c == '\\' && parser.pos < len(parser.src):
+			parser.next()
+		case c == '"':
+			return nil
+		case c == '\n':
+			return parser.errorf("unterminated string")
+		case (c == '$' || c == '%') && parser.peek() == '{':
+			parser.next()
+			if err := parser.skipInterpolation(); err != nil {
+				return err
+			}
+		}
+	}
+	return parser.errorf("unterminated string")
+}
+
+// skipInterpolation skips the expression of a template interpolation, up to and including its closing brace.
+func (parser *tfParser) skipInterpolation() error {
+	depth := 0
+	for parser.pos < len(parser.src) {
+		switch parser.peek() {
+		case '"':
+			if err := parser.skipString(); err != nil {
+				return err
+			}
+			continue
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				parser.next()
+				return nil
+			}
+			depth--
+		}
+		parser.next()
+	}
+	return parser.errorf("unterminated interpolation")
+}
+
+// skipHeredoc skips a heredoc string (<<EOT or <<-EOT).
+func (parser *tfParser) skipHeredoc() error {
+	parser.pos += 2
+	if parser.peek() == '-' {
+		parser.next()
+	}
+	marker := parser.identifier()
+	if marker == "" {
+		return parser.errorf("invalid heredoc")
+	}
+	for parser.pos < len(parser.src) {
+		for parser.pos < len(parser.src) && parser.next() != '\n' {
+		}
+		end := bytes.IndexByte(parser.src[parser.pos:], '\n')
+		if end < 0 {
+			end = len(parser.src) - parser.pos
+		}
+		if strings.TrimSpace(string(parser.src[parser.pos:parser.pos+end])) == marker {
+			parser.pos += end
+			return nil
+		}
+	}
+	return parser.errorf("unterminated heredoc '%s'", marker)
+}
+
+// tfString returns the value of an expression that is a string literal without interpolations.
+func tfString(expr string) (string, bool) {
+	if !strings.HasPrefix(expr, `"`) || strings.Contains(expr, "${") || strings.Contains(expr, "%{") {
+		return "", false
+	}
+	value, err := strconv.Unquote(expr)
+	return value, err == nil
+}
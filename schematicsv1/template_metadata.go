@@ -0,0 +1,356 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Template types supported by template metadata extraction.
+const (
+	TemplateMetadataTypeTerraform = "terraform"
+	TemplateMetadataTypeAnsible   = "ansible"
+)
+
+// Patterns of the validation conditions of Terraform variables that are translated to variable metadata.
+// NAME is replaced by the quoted variable name.
+var (
+	tfRegexConditionPattern    = `^can\(\s*regex\(\s*("(?:[^"\\]|\\.)*")\s*,\s*var\.NAME\s*\)\s*\)$`
+	tfContainsConditionPattern = `^contains\(\s*\[(.*)\]\s*,\s*var\.NAME\s*\)$`
+	tfLengthConditionPattern   = `^length\(\s*var\.NAME\s*\)\s*(>=|<=|>|<|==)\s*(\d+)$`
+	tfValueConditionPattern    = `^var\.NAME\s*(>=|<=|>|<|==)\s*(-?\d+)$`
+)
+
+// tfStringListItemPattern matches the items of a list of string literals.
+var tfStringListItemPattern = regexp.MustCompile(`^\s*("(?:[^"\\]|\\.)*")\s*$`)
+
+// TemplateMetadataOptions : the options of template metadata extraction.
+type TemplateMetadataOptions struct {
+	// The template type: TemplateMetadataTypeTerraform (the default, also matching versioned types such as
+	// "terraform_v1.5") or TemplateMetadataTypeAnsible.
+	TemplateType string
+
+	// The folder of the template, relative to the root of the directory or archive.
+	Folder string
+}
+
+// ExtractTemplateMetadataFromDir returns the variables of a local template directory, without a request to the
+// service. See ExtractTemplateMetadataFromArchive.
+func ExtractTemplateMetadataFromDir(dir string, options *TemplateMetadataOptions) (*TemplateMetaDataResponse, error) {
+	templateType, err := templateMetadataType(options)
+	if err != nil {
+		return nil, err
+	}
+	files, err := readTemplateDir(dir, templateMetadataFilter(templateType))
+	if err != nil {
+		return nil, err
+	}
+	return extractTemplateMetadata(files, templateType, options)
+}
+
+// ExtractTemplateMetadataFromArchive returns the variables of a tar or gzipped tar template archive in the form
+// returned by ProcessTemplateMetaData, without a request to the service.
+//
+// For Terraform templates, the variable blocks of the .tf files in the template folder are read: the type,
+// description, default value and sensitivity of each variable are translated to its metadata, and a variable
+// without a default is required. Validation conditions of the forms can(regex("...", var.x)),
+// contains([...], var.x), length(var.x) <op> n and var.x <op> n, joined by &&, are translated to Matches, Options,
+// MinLength/MaxLength and MinValue/MaxValue. For Ansible templates, the vars of the plays of the playbooks in the
+// template folder and the variables of its group_vars/all file or of the YAML files of its group_vars/all directory
+// are read, with their values as defaults; variables
+// with names that suggest secrets (e.g. "password") are marked secure.
+func ExtractTemplateMetadataFromArchive(reader io.Reader, options *TemplateMetadataOptions) (*TemplateMetaDataResponse, error) {
+	templateType, err := templateMetadataType(options)
+	if err != nil {
+		return nil, err
+	}
+	files, err := readTemplateTar(reader, templateMetadataFilter(templateType))
+	if err != nil {
+		return nil, err
+	}
+	return extractTemplateMetadata(files, templateType, options)
+}
+
+func templateMetadataType(options *TemplateMetadataOptions) (string, error) {
+	if options == nil || options.TemplateType == "" || strings.HasPrefix(options.TemplateType, TemplateMetadataTypeTerraform) {
+		return TemplateMetadataTypeTerraform, nil
+	}
+	if options.TemplateType == TemplateMetadataTypeAnsible {
+		return TemplateMetadataTypeAnsible, nil
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("unsupported template type '%s'", options.TemplateType), "template-metadata-type-error", common.GetComponentInfo())
+}
+
+func templateMetadataFilter(templateType string) func(name string) bool {
+	if templateType == TemplateMetadataTypeAnsible {
+		return isAnsibleVariablesFile
+	}
+	return isTerraformFile
+}
+
+func isYAMLFile(name string) bool {
+	return strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml")
+}
+
+// isAnsibleVariablesFile reports whether name is a YAML file or a group_vars/all file, which has no extension.
+func isAnsibleVariablesFile(name string) bool {
+	return isYAMLFile(name) || path.Base(name) == "all" && path.Base(path.Dir(name)) == "group_vars"
+}
+
+func extractTemplateMetadata(files templateFiles, templateType string, options *TemplateMetadataOptions) (*TemplateMetaDataResponse, error) {
+	folder := ""
+	if options != nil {
+		folder = options.Folder
+	}
+	response := &TemplateMetaDataResponse{Type: core.StringPtr(templateType), Variables: []VariableData{}}
+	var err error
+	if templateType == TemplateMetadataTypeAnsible {
+		response.Variables, err = extractAnsibleVariables(files, folder)
+	} else {
+		response.Variables, err = extractTerraformVariables(files, folder)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Variables {
+		response.Variables[i].Metadata.Position = core.Int64Ptr(int64(i))
+	}
+	return response, nil
+}
+
+// extractTerraformVariables returns the variables declared by the .tf files of folder, in file and declaration order.
+func extractTerraformVariables(files templateFiles, folder string) (variables []VariableData, err error) {
+	for _, name := range files.inFolder(folder, ".tf") {
+		body, err := parseTerraformFile(files[name])
+		if err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("%s: %s", name, err.Error()), "template-metadata-parse-error", common.GetComponentInfo())
+		}
+		for _, block := range body.blocks("variable") {
+			if len(block.Labels) == 1 {
+				variables = append(variables, newTerraformVariableData(block))
+			}
+		}
+	}
+	return variables, nil
+}
+
+func newTerraformVariableData(block *tfBlock) VariableData {
+	name := block.Labels[0]
+	metadata := &VariableMetadata{}
+	variable := VariableData{Name: core.StringPtr(name), Metadata: metadata}
+
+	if description, ok := tfString(block.Attributes["description"].Expr); ok {
+		metadata.Description = core.StringPtr(description)
+	}
+	defaultAttribute, hasDefault := block.Attributes["default"]
+	if hasDefault && defaultAttribute.Expr != "null" {
+		if value, ok := tfString(defaultAttribute.Expr); ok {
+			metadata.DefaultValue = core.StringPtr(value)
+		} else {
+			metadata.DefaultValue = core.StringPtr(defaultAttribute.Expr)
+		}
+	}
+	metadata.Required = core.BoolPtr(!hasDefault)
+	if block.Attributes["sensitive"].Expr == "true" {
+		metadata.Secure = core.BoolPtr(true)
+	}
+	metadata.Type = core.StringPtr(terraformVariableType(block.Attributes["type"].Expr, defaultAttribute.Expr))
+
+	quotedName := regexp.QuoteMeta(name)
+	for _, validation := range block.blocks("validation") {
+		for _, condition := range strings.Split(validation.Attributes["condition"].Expr, "&&") {
+			condition = strings.TrimSpace(condition)
+			if match := matchCondition(tfRegexConditionPattern, quotedName, condition); match != nil {
+				if pattern, err := strconv.Unquote(match[1]); err == nil {
+					metadata.Matches = core.StringPtr(pattern)
+				}
+			} else if match := matchCondition(tfContainsConditionPattern, quotedName, condition); match != nil {
+				if options, ok := tfStringList(match[1]); ok {
+					metadata.Options = options
+				}
+			} else if match := matchCondition(tfLengthConditionPattern, quotedName, condition); match != nil {
+				metadata.MinLength, metadata.MaxLength = applyBound(match[1], match[2], metadata.MinLength, metadata.MaxLength)
+			} else if match := matchCondition(tfValueConditionPattern, quotedName, condition); match != nil {
+				metadata.MinValue, metadata.MaxValue = applyBound(match[1], match[2], metadata.MinValue, metadata.MaxValue)
+			}
+		}
+	}
+	return variable
+}
+
+func matchCondition(pattern string, quotedName string, condition string) []string {
+	return regexp.MustCompile(strings.Replace(pattern, "NAME", quotedName, 1)).FindStringSubmatch(condition)
+}
+
+// applyBound narrows the inclusive bounds min and max by the comparison "<op> value".
+func applyBound(operator string, value string, min *int64, max *int64) (*int64, *int64) {
+	bound, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return min, max
+	}
+	switch operator {
+	case ">":
+		min = core.Int64Ptr(bound + 1)
+	case ">=":
+		min = core.Int64Ptr(bound)
+	case "<":
+		max = core.Int64Ptr(bound - 1)
+	case "<=":
+		max = core.Int64Ptr(bound)
+	case "==":
+		min, max = core.Int64Ptr(bound), core.Int64Ptr(bound)
+	}
+	return min, max
+}
+
+// tfStringList returns the values of a comma-separated list of string literals.
+func tfStringList(expr string) (values []string, ok bool) {
+	for _, item := range strings.Split(expr, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		match := tfStringListItemPattern.FindStringSubmatch(item)
+		if match == nil {
+			return nil, false
+		}
+		value, err := strconv.Unquote(match[1])
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+// terraformVariableType returns the VariableMetadata type of a Terraform type constraint, or of the default value
+// if there is no type constraint. The metadata has no floating point type, so numbers are integers.
+func terraformVariableType(typeExpr string, defaultExpr string) string {
+	typeExpr = strings.TrimSpace(typeExpr)
+	switch {
+	case typeExpr == "string":
+		return VariableMetadata_Type_String
+	case typeExpr == "number":
+		return VariableMetadata_Type_Integer
+	case typeExpr == "bool":
+		return VariableMetadata_Type_Boolean
+	case strings.HasPrefix(typeExpr, "list") || strings.HasPrefix(typeExpr, "set") || strings.HasPrefix(typeExpr, "tuple"):
+		return VariableMetadata_Type_List
+	case strings.HasPrefix(typeExpr, "map"):
+		return VariableMetadata_Type_Map
+	case typeExpr != "":
+		return VariableMetadata_Type_Complex
+	}
+	switch defaultExpr = strings.TrimSpace(defaultExpr); {
+	case defaultExpr == "true" || defaultExpr == "false":
+		return VariableMetadata_Type_Boolean
+	case strings.HasPrefix(defaultExpr, "["):
+		return VariableMetadata_Type_List
+	case strings.HasPrefix(defaultExpr, "{"):
+		return VariableMetadata_Type_Map
+	}
+	if _, err := strconv.ParseFloat(defaultExpr, 64); err == nil {
+		return VariableMetadata_Type_Integer
+	}
+	return VariableMetadata_Type_String
+}
+
+// extractAnsibleVariables returns the variables of the plays of the playbooks of folder, followed by those of its
+// group_vars/all file (all, all.yml or all.yaml) and of the YAML files of its group_vars/all directory in name order.
+// Each variable is returned once, with its first value.
+func extractAnsibleVariables(files templateFiles, folder string) (variables []VariableData, err error) {
+	seen := map[string]bool{}
+	add := func(vars yaml.Node) {
+		if vars.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(vars.Content); i += 2 {
+			name := vars.Content[i].Value
+			if !seen[name] {
+				seen[name] = true
+				variables = append(variables, newAnsibleVariableData(name, vars.Content[i+1]))
+			}
+		}
+	}
+
+	for _, name := range files.inFolder(folder, ".yml", ".yaml") {
+		var plays []struct {
+			Vars yaml.Node `yaml:"vars"`
+		}
+		if err := yaml.Unmarshal(files[name], &plays); err != nil {
+			// Files that are not playbooks, such as variable files, are not lists of plays.
+			continue
+		}
+		for _, play := range plays {
+			add(play.Vars)
+		}
+	}
+	groupVars := path.Join(folder, "group_vars", "all")
+	names := files.inFolder(path.Dir(groupVars), "/all", "/all.yml", "/all.yaml")
+	names = append(names, files.inFolder(groupVars, ".yml", ".yaml")...)
+	for _, name := range names {
+		var vars yaml.Node
+		if err := yaml.Unmarshal(files[name], &vars); err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("%s: %s", name, err.Error()), "template-metadata-parse-error", common.GetComponentInfo())
+		}
+		if len(vars.Content) > 0 {
+			add(*vars.Content[0])
+		}
+	}
+	return variables, nil
+}
+
+func newAnsibleVariableData(name string, value *yaml.Node) VariableData {
+	metadata := &VariableMetadata{Required: core.BoolPtr(false)}
+	switch {
+	case value.Kind == yaml.SequenceNode:
+		metadata.Type = core.StringPtr(VariableMetadata_Type_List)
+	case value.Kind == yaml.MappingNode:
+		metadata.Type = core.StringPtr(VariableMetadata_Type_Map)
+	case value.Tag == "!!bool":
+		metadata.Type = core.StringPtr(VariableMetadata_Type_Boolean)
+	case value.Tag == "!!int" || value.Tag == "!!float":
+		metadata.Type = core.StringPtr(VariableMetadata_Type_Integer)
+	default:
+		metadata.Type = core.StringPtr(VariableMetadata_Type_String)
+	}
+	if value.Kind == yaml.ScalarNode {
+		if value.Tag != "!!null" {
+			metadata.DefaultValue = core.StringPtr(value.Value)
+		}
+	} else {
+		var decoded interface{}
+		if value.Decode(&decoded) == nil {
+			if encoded, err := json.Marshal(decoded); err == nil {
+				metadata.DefaultValue = core.StringPtr(string(encoded))
+			}
+		}
+	}
+	if sensitiveNamePattern.MatchString(name) {
+		metadata.Secure = core.BoolPtr(true)
+	}
+	return VariableData{Name: core.StringPtr(name), Metadata: metadata}
+}
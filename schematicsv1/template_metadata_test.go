@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 template metadata`, func() {
+	var dir string
+
+	const variablesTf = `
+variable "ibmcloud_api_key" {
+  description = "The API key"
+  type        = string
+  sensitive   = true
+}
+
+variable "prefix" {
+  type    = string
+  default = "app"
+  validation {
+    condition     = can(regex("^[a-z][a-z0-9-]*$", var.prefix)) && length(var.prefix) <= 16
+    error_message = "Invalid prefix."
+  }
+}
+
+variable "zone_count" {
+  type    = number
+  default = 2
+  validation {
+    condition     = var.zone_count >= 1 && var.zone_count < 4
+    error_message = "Invalid zone count."
+  }
+}
+
+variable "profile" {
+  default = "bx2-2x8"
+  validation {
+    condition     = contains(["bx2-2x8", "cx2-4x8"], var.profile)
+    error_message = "Invalid profile."
+  }
+}
+`
+	const mainTf = `
+variable "tags" {
+  type    = list(string)
+  default = ["a", "b"]
+}
+variable "labels" { default = { env = "dev" } }
+variable "settings" { type = object({ enabled = bool }) }
+variable "optional" {
+  type    = string
+  default = null
+}
+variable "enabled" { default = true }
+resource "null_resource" "x" {}
+`
+
+	variable := func(response *schematicsv1.TemplateMetaDataResponse, name string) *schematicsv1.VariableMetadata {
+		for _, variable := range response.Variables {
+			if *variable.Name == name {
+				return variable.Metadata
+			}
+		}
+		Fail("no variable " + name)
+		return nil
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "template-metadata")
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{
+			"infra/variables.tf": variablesTf,
+			"infra/main.tf":      mainTf,
+			"infra/README.md":    "docs",
+			"playbooks/site.yml": `
+- hosts: all
+  vars:
+    http_port: 80
+    app_name: web
+    db_password: secret
+    packages: [nginx, git]
+    debug: false
+  tasks: []
+- hosts: db
+  vars:
+    http_port: 8080
+    db_name: app
+`,
+			"playbooks/vars.yml":           "extra: value\n",
+			"playbooks/group_vars/all.yml": "ntp_server: time.example.com\nlimits:\n  cpu: 2\n",
+		})
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Extract Terraform variables`, func() {
+		response, err := schematicsv1.ExtractTemplateMetadataFromDir(dir, &schematicsv1.TemplateMetadataOptions{TemplateType: "terraform_v1.5", Folder: "infra"})
+		Expect(err).To(BeNil())
+		Expect(*response.Type).To(Equal(schematicsv1.TemplateMetadataTypeTerraform))
+
+		var names []string
+		for _, variable := range response.Variables {
+			names = append(names, *variable.Name)
+		}
+		Expect(names).To(Equal([]string{"tags", "labels", "settings", "optional", "enabled", "ibmcloud_api_key", "prefix", "zone_count", "profile"}))
+
+		Expect(variable(response, "ibmcloud_api_key")).To(Equal(&schematicsv1.VariableMetadata{
+			Type:        core.StringPtr(schematicsv1.VariableMetadata_Type_String),
+			Description: core.StringPtr("The API key"),
+			Required:    core.BoolPtr(true),
+			Secure:      core.BoolPtr(true),
+			Position:    core.Int64Ptr(5),
+		}))
+		Expect(variable(response, "prefix")).To(Equal(&schematicsv1.VariableMetadata{
+			Type:         core.StringPtr(schematicsv1.VariableMetadata_Type_String),
+			DefaultValue: core.StringPtr("app"),
+			Required:     core.BoolPtr(false),
+			Matches:      core.StringPtr("^[a-z][a-z0-9-]*$"),
+			MaxLength:    core.Int64Ptr(16),
+			Position:     core.Int64Ptr(6),
+		}))
+
+		zoneCount := variable(response, "zone_count")
+		Expect(*zoneCount.Type).To(Equal(schematicsv1.VariableMetadata_Type_Integer))
+		Expect(*zoneCount.DefaultValue).To(Equal("2"))
+		Expect(*zoneCount.MinValue).To(Equal(int64(1)))
+		Expect(*zoneCount.MaxValue).To(Equal(int64(3)))
+
+		profile := variable(response, "profile")
+		Expect(*profile.Type).To(Equal(schematicsv1.VariableMetadata_Type_String))
+		Expect(profile.Options).To(Equal([]string{"bx2-2x8", "cx2-4x8"}))
+
+		Expect(*variable(response, "tags").Type).To(Equal(schematicsv1.VariableMetadata_Type_List))
+		Expect(*variable(response, "tags").DefaultValue).To(Equal(`["a", "b"]`))
+		Expect(*variable(response, "labels").Type).To(Equal(schematicsv1.VariableMetadata_Type_Map))
+		Expect(*variable(response, "settings").Type).To(Equal(schematicsv1.VariableMetadata_Type_Complex))
+		Expect(*variable(response, "settings").Required).To(BeTrue())
+		Expect(variable(response, "optional").DefaultValue).To(BeNil())
+		Expect(*variable(response, "optional").Required).To(BeFalse())
+		Expect(*variable(response, "enabled").Type).To(Equal(schematicsv1.VariableMetadata_Type_Boolean))
+	})
+	It(`Extract Ansible variables`, func() {
+		response, err := schematicsv1.ExtractTemplateMetadataFromDir(dir, &schematicsv1.TemplateMetadataOptions{TemplateType: "ansible", Folder: "playbooks"})
+		Expect(err).To(BeNil())
+		Expect(*response.Type).To(Equal(schematicsv1.TemplateMetadataTypeAnsible))
+
+		var names []string
+		for _, variable := range response.Variables {
+			names = append(names, *variable.Name)
+		}
+		Expect(names).To(Equal([]string{"http_port", "app_name", "db_password", "packages", "debug", "db_name", "ntp_server", "limits"}))
+
+		Expect(*variable(response, "http_port").Type).To(Equal(schematicsv1.VariableMetadata_Type_Integer))
+		Expect(*variable(response, "http_port").DefaultValue).To(Equal("80"))
+		Expect(*variable(response, "db_password").Secure).To(BeTrue())
+		Expect(variable(response, "app_name").Secure).To(BeNil())
+		Expect(*variable(response, "packages").Type).To(Equal(schematicsv1.VariableMetadata_Type_List))
+		Expect(*variable(response, "packages").DefaultValue).To(Equal(`["nginx","git"]`))
+		Expect(*variable(response, "debug").Type).To(Equal(schematicsv1.VariableMetadata_Type_Boolean))
+		Expect(*variable(response, "limits").Type).To(Equal(schematicsv1.VariableMetadata_Type_Map))
+		Expect(*variable(response, "limits").DefaultValue).To(Equal(`{"cpu":2}`))
+	})
+	It(`Extract Ansible variables from a group_vars/all file without extension and a group_vars/all directory`, func() {
+		Expect(os.Remove(filepath.Join(dir, "playbooks", "group_vars", "all.yml"))).To(Succeed())
+		writeFiles(dir, map[string]string{
+			"playbooks/group_vars/all":          "ntp_server: time.example.com\n",
+			"playbooks/group_vars/webservers":   "worker_count: 4\n",
+			"site/site.yml":                     "- hosts: all\n  tasks: []\n",
+			"site/group_vars/all/network.yml":   "subnet: 10.0.0.0/24\nregion: us-south\n",
+			"site/group_vars/all/accounts.yaml": "admin_password: secret\nregion: eu-de\n",
+			"site/group_vars/all/README.md":     "docs",
+		})
+
+		response, err := schematicsv1.ExtractTemplateMetadataFromDir(dir, &schematicsv1.TemplateMetadataOptions{TemplateType: "ansible", Folder: "playbooks"})
+		Expect(err).To(BeNil())
+		Expect(*variable(response, "ntp_server").DefaultValue).To(Equal("time.example.com"))
+		for _, variable := range response.Variables {
+			Expect(*variable.Name).ToNot(Equal("worker_count"))
+		}
+
+		archive := &bytes.Buffer{}
+		_, err = schematicsv1.NewTemplateArchive(dir).WriteTo(archive)
+		Expect(err).To(BeNil())
+		response, err = schematicsv1.ExtractTemplateMetadataFromArchive(archive, &schematicsv1.TemplateMetadataOptions{TemplateType: "ansible", Folder: "site"})
+		Expect(err).To(BeNil())
+		var names []string
+		for _, variable := range response.Variables {
+			names = append(names, *variable.Name)
+		}
+		Expect(names).To(Equal([]string{"admin_password", "region", "subnet"}))
+		Expect(*variable(response, "admin_password").Secure).To(BeTrue())
+		Expect(*variable(response, "region").DefaultValue).To(Equal("eu-de"))
+	})
+	It(`Extract variables from an archive`, func() {
+		archive := &bytes.Buffer{}
+		_, err := schematicsv1.NewTemplateArchive(dir).WriteTo(archive)
+		Expect(err).To(BeNil())
+
+		response, err := schematicsv1.ExtractTemplateMetadataFromArchive(archive, &schematicsv1.TemplateMetadataOptions{Folder: "infra"})
+		Expect(err).To(BeNil())
+		Expect(response.Variables).To(HaveLen(9))
+	})
+	It(`Fail for unsupported types and invalid configuration`, func() {
+		_, err := schematicsv1.ExtractTemplateMetadataFromDir(dir, &schematicsv1.TemplateMetadataOptions{TemplateType: "helm"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unsupported template type 'helm'"))
+
+		writeFiles(dir, map[string]string{"infra/broken.tf": "variable \"x\" {\n  default = \"unterminated\n}\n"})
+		_, err = schematicsv1.ExtractTemplateMetadataFromDir(dir, &schematicsv1.TemplateMetadataOptions{Folder: "infra"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("infra/broken.tf"))
+	})
+})
@@ -0,0 +1,370 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// DefaultUploadProgressInterval is the minimum interval between progress reports of an upload if
+// UploadOptions.ProgressInterval is not set.
+const DefaultUploadProgressInterval = 250 * time.Millisecond
+
+// UploadProgress : the progress of a template upload.
+type UploadProgress struct {
+	// The operationId of the upload (e.g. "TemplateRepoUpload").
+	OperationID string
+
+	// The number of bytes of the file sent so far. It restarts from 0 when the upload is retried.
+	BytesSent int64
+
+	// The size of the file, or -1 if it is unknown.
+	TotalBytes int64
+
+	// The average rate of the upload since it started, in bytes per second.
+	Rate float64
+
+	// The estimated time until the file is sent, or -1 if the size of the file or the rate is unknown.
+	ETA time.Duration
+
+	// The time since the upload started.
+	Elapsed time.Duration
+
+	// Whether the whole file has been sent. The final report of an upload has Done set.
+	Done bool
+}
+
+// UploadProgressFunc : receives the progress of an upload. It is called by the goroutine that sends the request
+// body, so it must return quickly and must not call back into the upload.
+type UploadProgressFunc func(progress UploadProgress)
+
+// UploadOptions : the options of a streamed template upload.
+type UploadOptions struct {
+	// Receives the progress of the upload, if set.
+	Progress UploadProgressFunc
+
+	// The minimum interval between progress reports; DefaultUploadProgressInterval if 0.
+	ProgressInterval time.Duration
+
+	// The size of the file, if it cannot be determined from the file itself. The size of files with a Len method
+	// (such as *bytes.Reader) and of regular *os.File files is determined automatically.
+	Size int64
+}
+
+// TemplateRepoUploadWithProgress uploads a template tar file to a workspace like TemplateRepoUploadWithContext,
+// but streams the multipart request body from templateRepoUploadOptions.File without buffering it and reports
+// its progress. See UploadTemplateTarActionWithProgress.
+func (schematics *SchematicsV1) TemplateRepoUploadWithProgress(ctx context.Context, templateRepoUploadOptions *TemplateRepoUploadOptions, uploadOptions *UploadOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(templateRepoUploadOptions, "templateRepoUploadOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(templateRepoUploadOptions, "templateRepoUploadOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	pathParamsMap := map[string]string{
+		"w_id": *templateRepoUploadOptions.WID,
+		"t_id": *templateRepoUploadOptions.TID,
+	}
+	return schematics.streamTemplateUpload(ctx, "TemplateRepoUpload", `/v1/workspaces/{w_id}/template_data/{t_id}/template_repo_upload`, pathParamsMap,
+		templateRepoUploadOptions, templateRepoUploadOptions.File, core.StringNilMapper(templateRepoUploadOptions.FileContentType), templateRepoUploadOptions.Headers, uploadOptions)
+}
+
+// UploadTemplateTarActionWithProgress uploads a template tar file to an action like
+// UploadTemplateTarActionWithContext, but streams the multipart request body from
+// uploadTemplateTarActionOptions.File instead of handing it to the HTTP client as an opaque stream.
+//
+// The body is read from the file only as fast as it is sent, and is never held in memory as a whole: if the file
+// is seekable (such as an *os.File or a *bytes.Reader), retries rewind it instead of buffering the body; the body
+// of other files is buffered only if retries are enabled, since it cannot be sent again otherwise. If the size of
+// the file is known, the request has a Content-Length instead of a chunked body. The upload stops reading
+// the file as soon as ctx is done and returns the context's error; an error reading the file also aborts the
+// request rather than sending a truncated body. The file is closed when the upload completes. The body is not
+// gzip-compressed, even if gzip compression is enabled for the service, since template archives are compressed
+// already.
+func (schematics *SchematicsV1) UploadTemplateTarActionWithProgress(ctx context.Context, uploadTemplateTarActionOptions *UploadTemplateTarActionOptions, uploadOptions *UploadOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(uploadTemplateTarActionOptions, "uploadTemplateTarActionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(uploadTemplateTarActionOptions, "uploadTemplateTarActionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	pathParamsMap := map[string]string{
+		"action_id": *uploadTemplateTarActionOptions.ActionID,
+	}
+	return schematics.streamTemplateUpload(ctx, "UploadTemplateTarAction", `/v2/actions/{action_id}/template_repo_upload`, pathParamsMap,
+		uploadTemplateTarActionOptions, uploadTemplateTarActionOptions.File, core.StringNilMapper(uploadTemplateTarActionOptions.FileContentType), uploadTemplateTarActionOptions.Headers, uploadOptions)
+}
+
+func (schematics *SchematicsV1) streamTemplateUpload(ctx context.Context, operationID string, path string, pathParamsMap map[string]string, options interface{},
+	file io.ReadCloser, contentType string, headers map[string]string, uploadOptions *UploadOptions) (result *TemplateRepoTarUploadResponse, response *core.DetailedResponse, err error) {
+	if file == nil {
+		err = core.SDKErrorf(nil, "file must be supplied", "condition-not-met", common.GetComponentInfo())
+		return
+	}
+	defer file.Close()
+	if uploadOptions == nil {
+		uploadOptions = &UploadOptions{}
+	}
+
+	builder := core.NewRequestBuilder(core.PUT)
+	builder = builder.WithContext(ctx)
+	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", operationID, headers)
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	body, err := newUploadBody(ctx, operationID, file, contentType, uploadOptions)
+	if err != nil {
+		return
+	}
+	builder.AddHeader("Content-Type", body.contentType)
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+	request.ContentLength = body.contentLength()
+	if body.seekable {
+		request.Body = &seekableUploadBody{body}
+	} else {
+		request.Body = body
+	}
+
+	response, err = schematics.invoke(ctx, operationID, options, request, &result, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		var rawResponse map[string]json.RawMessage
+		response, err = schematics.Service.Request(request, &rawResponse)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "template_repo_upload", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
+		if rawResponse != nil {
+			err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalTemplateRepoTarUploadResponse)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+				return
+			}
+			response.Result = result
+		}
+		return
+	})
+	return
+}
+
+// uploadBody : a multipart/form-data request body with a single "file" part, read from the file as it is sent.
+type uploadBody struct {
+	ctx         context.Context
+	file        io.Reader
+	prefix      []byte
+	trailer     []byte
+	contentType string
+	seekable    bool
+	start       int64
+	progress    *uploadProgressReporter
+	reader      io.Reader
+}
+
+func newUploadBody(ctx context.Context, operationID string, file io.Reader, fileContentType string, uploadOptions *UploadOptions) (*uploadBody, error) {
+	form := &bytes.Buffer{}
+	formWriter := multipart.NewWriter(form)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="filename"`)
+	if fileContentType != "" {
+		header.Set("Content-Type", fileContentType)
+	}
+	if _, err := formWriter.CreatePart(header); err != nil {
+		return nil, core.SDKErrorf(err, "", "create-part-error", common.GetComponentInfo())
+	}
+	prefixLength := form.Len()
+	if err := formWriter.Close(); err != nil {
+		return nil, core.SDKErrorf(err, "", "form-close-error", common.GetComponentInfo())
+	}
+
+	body := &uploadBody{
+		ctx:         ctx,
+		file:        file,
+		prefix:      form.Bytes()[:prefixLength],
+		trailer:     form.Bytes()[prefixLength:],
+		contentType: formWriter.FormDataContentType(),
+	}
+	if seeker, ok := file.(io.Seeker); ok {
+		if start, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			body.seekable = true
+			body.start = start
+		}
+	}
+	size := uploadOptions.Size
+	if size <= 0 {
+		size = body.fileSize()
+	}
+	interval := uploadOptions.ProgressInterval
+	if interval <= 0 {
+		interval = DefaultUploadProgressInterval
+	}
+	body.progress = &uploadProgressReporter{operationID: operationID, callback: uploadOptions.Progress, interval: interval, total: size}
+	body.rewind()
+	return body, nil
+}
+
+// fileSize returns the number of bytes left in the file, or -1 if it is unknown.
+func (body *uploadBody) fileSize() int64 {
+	switch file := body.file.(type) {
+	case interface{ Len() int }:
+		return int64(file.Len())
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if info, err := file.Stat(); err == nil && info.Mode().IsRegular() && body.seekable {
+			return info.Size() - body.start
+		}
+	}
+	return -1
+}
+
+func (body *uploadBody) contentLength() int64 {
+	if body.progress.total < 0 {
+		return -1
+	}
+	return int64(len(body.prefix)) + body.progress.total + int64(len(body.trailer))
+}
+
+func (body *uploadBody) rewind() {
+	body.progress.reset()
+	body.reader = io.MultiReader(bytes.NewReader(body.prefix), &uploadFileReader{body}, bytes.NewReader(body.trailer))
+}
+
+func (body *uploadBody) Read(p []byte) (int, error) {
+	if err := body.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return body.reader.Read(p)
+}
+
+// Close does not close the file, since the HTTP client closes the body of each attempt of a retried request.
+func (body *uploadBody) Close() error {
+	return nil
+}
+
+// uploadFileReader : reads the file part of an uploadBody and reports its progress.
+type uploadFileReader struct {
+	body *uploadBody
+}
+
+func (reader *uploadFileReader) Read(p []byte) (int, error) {
+	n, err := reader.body.file.Read(p)
+	reader.body.progress.add(int64(n), err == io.EOF)
+	if err != nil && err != io.EOF {
+		err = core.SDKErrorf(err, fmt.Sprintf("reading the file to upload failed: %s", err.Error()), "upload-read-error", common.GetComponentInfo())
+	}
+	return n, err
+}
+
+// seekableUploadBody : an uploadBody of a seekable file, which the HTTP client can rewind to retry the request
+// without buffering the body.
+type seekableUploadBody struct {
+	*uploadBody
+}
+
+// Seek supports only rewinding the body to its start.
+func (body *seekableUploadBody) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, core.SDKErrorf(nil, "an upload body can only be rewound to its start", "upload-seek-error", common.GetComponentInfo())
+	}
+	if _, err := body.file.(io.Seeker).Seek(body.start, io.SeekStart); err != nil {
+		return 0, err
+	}
+	body.rewind()
+	return 0, nil
+}
+
+// uploadProgressReporter : tracks the progress of an upload and reports it at most once per interval.
+type uploadProgressReporter struct {
+	operationID string
+	callback    UploadProgressFunc
+	interval    time.Duration
+	total       int64
+	sent        int64
+	started     time.Time
+	reported    time.Time
+	done        bool
+}
+
+func (reporter *uploadProgressReporter) reset() {
+	reporter.sent = 0
+	reporter.done = false
+	reporter.started = time.Now()
+	reporter.reported = time.Time{}
+}
+
+func (reporter *uploadProgressReporter) add(n int64, eof bool) {
+	if reporter.callback == nil || reporter.done {
+		return
+	}
+	reporter.sent += n
+	now := time.Now()
+	if !eof && now.Sub(reporter.reported) < reporter.interval {
+		return
+	}
+	reporter.reported = now
+	reporter.done = eof
+
+	progress := UploadProgress{
+		OperationID: reporter.operationID,
+		BytesSent:   reporter.sent,
+		TotalBytes:  reporter.total,
+		Elapsed:     now.Sub(reporter.started),
+		ETA:         -1,
+		Done:        eof,
+	}
+	if progress.Elapsed > 0 {
+		progress.Rate = float64(reporter.sent) / progress.Elapsed.Seconds()
+	}
+	switch {
+	case eof:
+		progress.ETA = 0
+	case reporter.total >= 0 && progress.Rate > 0:
+		progress.ETA = time.Duration(float64(reporter.total-reporter.sent) / progress.Rate * float64(time.Second))
+	}
+	reporter.callback(progress)
+}
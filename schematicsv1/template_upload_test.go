@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing/iotest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// closeRecorder records whether an upload closed its file.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (recorder *closeRecorder) Close() error {
+	recorder.closed = true
+	return nil
+}
+
+var _ = Describe(`SchematicsV1 template uploads`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var mutex sync.Mutex
+	var uploads [][]byte
+	var contentLengths []int64
+	var failures int
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 64*1024)
+
+	recordProgress := func(reports *[]schematicsv1.UploadProgress) *schematicsv1.UploadOptions {
+		return &schematicsv1.UploadOptions{
+			ProgressInterval: time.Nanosecond,
+			Progress: func(progress schematicsv1.UploadProgress) {
+				*reports = append(*reports, progress)
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		uploads = nil
+		contentLengths = nil
+		failures = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			file, header, err := req.FormFile("file")
+			if err != nil {
+				res.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			Expect(header.Filename).To(Equal("filename"))
+			Expect(header.Header.Get("Content-Type")).To(Equal("application/gzip"))
+			data, _ := io.ReadAll(file)
+			mutex.Lock()
+			uploads = append(uploads, data)
+			contentLengths = append(contentLengths, req.ContentLength)
+			fail := failures > 0
+			failures--
+			mutex.Unlock()
+			if fail {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"file_value": "`+req.URL.Path+`", "has_received_file": true}`)
+		}))
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Stream a workspace template and report its progress`, func() {
+		var reports []schematicsv1.UploadProgress
+		options := schematicsService.NewTemplateRepoUploadOptions("w1", "t1")
+		options.SetFile(io.NopCloser(bytes.NewReader(content)))
+		options.SetFileContentType("application/gzip")
+
+		uploadOptions := recordProgress(&reports)
+		uploadOptions.Size = int64(len(content))
+
+		result, _, err := schematicsService.TemplateRepoUploadWithProgress(context.Background(), options, uploadOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.FileValue).To(Equal("/v1/workspaces/w1/template_data/t1/template_repo_upload"))
+		Expect(uploads).To(Equal([][]byte{content}))
+		Expect(contentLengths[0]).To(BeNumerically(">", len(content)))
+
+		Expect(len(reports)).To(BeNumerically(">", 1))
+		for i, report := range reports {
+			Expect(report.OperationID).To(Equal("TemplateRepoUpload"))
+			Expect(report.TotalBytes).To(Equal(int64(len(content))))
+			Expect(report.Done).To(Equal(i == len(reports)-1))
+			if i > 0 {
+				Expect(report.BytesSent).To(BeNumerically(">=", reports[i-1].BytesSent))
+			}
+		}
+		last := reports[len(reports)-1]
+		Expect(last.BytesSent).To(Equal(int64(len(content))))
+		Expect(last.ETA).To(Equal(time.Duration(0)))
+		Expect(last.Rate).To(BeNumerically(">", 0))
+	})
+	It(`Rewind a seekable file when the upload is retried`, func() {
+		dir, err := os.MkdirTemp("", "template-upload")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "template.tar.gz")
+		Expect(os.WriteFile(path, content, 0644)).To(Succeed())
+		file, err := os.Open(path)
+		Expect(err).To(BeNil())
+
+		schematicsService.EnableRetries(2, time.Second)
+		failures = 1
+		var reports []schematicsv1.UploadProgress
+		options := schematicsService.NewUploadTemplateTarActionOptions("a1")
+		options.SetFile(file)
+		options.SetFileContentType("application/gzip")
+
+		result, _, err := schematicsService.UploadTemplateTarActionWithProgress(context.Background(), options, recordProgress(&reports))
+		Expect(err).To(BeNil())
+		Expect(*result.FileValue).To(Equal("/v2/actions/a1/template_repo_upload"))
+		Expect(uploads).To(Equal([][]byte{content, content}))
+		Expect(contentLengths[0]).To(Equal(contentLengths[1]))
+
+		var done int
+		for _, report := range reports {
+			if report.Done {
+				done++
+				Expect(report.BytesSent).To(Equal(int64(len(content))))
+			}
+		}
+		Expect(done).To(Equal(2))
+		Expect(file.Close()).ToNot(Succeed())
+	})
+	It(`Abort the upload when the context is canceled`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		reader, writer := io.Pipe()
+		go func() {
+			for {
+				if _, err := writer.Write(content[:4096]); err != nil {
+					return
+				}
+			}
+		}()
+		file := &closeRecorder{Reader: reader}
+		var reports []schematicsv1.UploadProgress
+		uploadOptions := recordProgress(&reports)
+		progress := uploadOptions.Progress
+		uploadOptions.Progress = func(report schematicsv1.UploadProgress) {
+			progress(report)
+			if report.BytesSent > 64*1024 {
+				cancel()
+			}
+		}
+		options := schematicsService.NewTemplateRepoUploadOptions("w1", "t1")
+		options.SetFile(file)
+		options.SetFileContentType("application/gzip")
+
+		_, _, err := schematicsService.TemplateRepoUploadWithProgress(ctx, options, uploadOptions)
+		reader.CloseWithError(io.ErrClosedPipe)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(file.closed).To(BeTrue())
+		Expect(reports[0].TotalBytes).To(Equal(int64(-1)))
+		Expect(reports[0].ETA).To(Equal(time.Duration(-1)))
+		Expect(reports[len(reports)-1].Done).To(BeFalse())
+	})
+	It(`Fail instead of sending a truncated file`, func() {
+		options := schematicsService.NewTemplateRepoUploadOptions("w1", "t1")
+		options.SetFile(io.NopCloser(io.MultiReader(bytes.NewReader(content[:1024]), iotest.ErrReader(errors.New("disk failure")))))
+		options.SetFileContentType("application/gzip")
+
+		_, _, err := schematicsService.TemplateRepoUploadWithProgress(context.Background(), options, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("disk failure"))
+		Expect(uploads).To(BeEmpty())
+
+		_, _, err = schematicsService.TemplateRepoUploadWithProgress(context.Background(), schematicsService.NewTemplateRepoUploadOptions("w1", "t1"), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("file must be supplied"))
+	})
+})
@@ -0,0 +1,316 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Severities of template validation issues.
+const (
+	TemplateIssueSeverityError   = "error"
+	TemplateIssueSeverityWarning = "warning"
+)
+
+// Rules checked by template validation.
+const (
+	TemplateRuleFolderMissing          = "folder-missing"
+	TemplateRuleNoTerraformFiles       = "no-terraform-files"
+	TemplateRuleSyntax                 = "syntax"
+	TemplateRuleRequiredVersionMissing = "required-version-missing"
+	TemplateRuleRequiredVersionInvalid = "required-version-invalid"
+	TemplateRuleRequiredVersion        = "required-version-incompatible"
+	TemplateRuleUndeclaredVariable     = "undeclared-variable"
+	TemplateRuleHardcodedCredential    = "hardcoded-credential"
+)
+
+// terraformTemplateTypePattern matches template types such as "terraform_v1.5".
+var terraformTemplateTypePattern = regexp.MustCompile(`^terraform_v(\d+)\.(\d+)$`)
+
+// versionConstraintPattern matches a single version constraint, such as ">= 1.3" or "~> 1.5.0".
+var versionConstraintPattern = regexp.MustCompile(`^(=|!=|>=|<=|>|<|~>)?\s*v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(-[0-9A-Za-z.-]+)?$`)
+
+// credentialAttributePattern matches the names of provider arguments that hold credentials.
+var credentialAttributePattern = regexp.MustCompile(`(?i)(api_?key|access_key|secret|password|token|private_key|client_secret|credentials)`)
+
+// TemplateValidationReport : the result of validating a template before it is uploaded.
+type TemplateValidationReport struct {
+	// The validated folder, relative to the root of the template ("" for the root).
+	Folder string `json:"folder"`
+
+	// The Terraform configuration files of the folder.
+	Files []string `json:"files"`
+
+	// The required_version constraints declared by the folder.
+	RequiredVersions []string `json:"required_versions,omitempty"`
+
+	// The variables declared by the folder.
+	Variables []string `json:"variables,omitempty"`
+
+	// The problems found, in the order they were found.
+	Issues []TemplateValidationIssue `json:"issues,omitempty"`
+}
+
+// TemplateValidationIssue : a problem found by template validation.
+type TemplateValidationIssue struct {
+	// TemplateIssueSeverityError or TemplateIssueSeverityWarning.
+	Severity string `json:"severity"`
+
+	// The rule that found the problem (TemplateRule*).
+	Rule string `json:"rule"`
+
+	// The file and line of the problem, if it has a location.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+
+	Message string `json:"message"`
+}
+
+// Valid reports whether the report has no errors.
+func (report *TemplateValidationReport) Valid() bool {
+	for _, issue := range report.Issues {
+		if issue.Severity == TemplateIssueSeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+// Errors returns the issues with error severity.
+func (report *TemplateValidationReport) Errors() (issues []TemplateValidationIssue) {
+	for _, issue := range report.Issues {
+		if issue.Severity == TemplateIssueSeverityError {
+			issues = append(issues, issue)
+		}
+	}
+	return
+}
+
+func (report *TemplateValidationReport) addIssue(severity string, rule string, file string, line int, format string, args ...interface{}) {
+	report.Issues = append(report.Issues, TemplateValidationIssue{
+		Severity: severity,
+		Rule:     rule,
+		File:     file,
+		Line:     line,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateTemplateDir checks a local template directory, as a TemplateArchive of it would be uploaded, against
+// the template data of a workspace. See ValidateTemplateArchive.
+func ValidateTemplateDir(dir string, templateData *TemplateSourceDataRequest) (*TemplateValidationReport, error) {
+	files, err := readTemplateDir(dir, isTerraformFile)
+	if err != nil {
+		return nil, err
+	}
+	return validateTemplate(files, templateData), nil
+}
+
+// ValidateTemplateArchive checks a tar or gzipped tar template archive against the template data of a workspace
+// before it is uploaded. It reports an error if the template folder (templateData.Folder) contains no
+// Terraform configuration, if its configuration cannot be parsed, if its required_version does not allow the
+// Terraform version of the template type (e.g. "terraform_v1.5"), if a variable of templateData.Variablestore is
+// not declared, or if a provider block has a hard-coded credential. A missing required_version is a warning.
+// templateData may be nil to check only the root folder's configuration.
+func ValidateTemplateArchive(reader io.Reader, templateData *TemplateSourceDataRequest) (*TemplateValidationReport, error) {
+	files, err := readTemplateTar(reader, isTerraformFile)
+	if err != nil {
+		return nil, err
+	}
+	return validateTemplate(files, templateData), nil
+}
+
+func isTerraformFile(name string) bool {
+	return strings.HasSuffix(name, ".tf")
+}
+
+func validateTemplate(files templateFiles, templateData *TemplateSourceDataRequest) *TemplateValidationReport {
+	if templateData == nil {
+		templateData = &TemplateSourceDataRequest{}
+	}
+	report := &TemplateValidationReport{Folder: strings.Trim(path.Clean("/"+core.StringNilMapper(templateData.Folder)), "/")}
+	if report.Folder != "" && !files.hasFolder(report.Folder) {
+		report.addIssue(TemplateIssueSeverityError, TemplateRuleFolderMissing, "", 0, "the template has no folder '%s'", report.Folder)
+		return report
+	}
+	report.Files = files.inFolder(report.Folder, ".tf")
+	if len(report.Files) == 0 {
+		report.addIssue(TemplateIssueSeverityError, TemplateRuleNoTerraformFiles, "", 0, "the folder '%s' contains no .tf files", report.Folder)
+		return report
+	}
+
+	declared := map[string]bool{}
+	type versionConstraint struct {
+		file       string
+		line       int
+		constraint string
+	}
+	var constraints []versionConstraint
+	for _, name := range report.Files {
+		body, err := parseTerraformFile(files[name])
+		if err != nil {
+			report.addIssue(TemplateIssueSeverityError, TemplateRuleSyntax, name, 0, "%s", err.Error())
+			continue
+		}
+		for _, block := range body.Blocks {
+			switch {
+			case block.Type == "variable" && len(block.Labels) == 1:
+				declared[block.Labels[0]] = true
+				report.Variables = append(report.Variables, block.Labels[0])
+			case block.Type == "terraform":
+				if attribute, ok := block.Attributes["required_version"]; ok {
+					constraint, ok := tfString(attribute.Expr)
+					if !ok {
+						report.addIssue(TemplateIssueSeverityError, TemplateRuleRequiredVersionInvalid, name, attribute.Line, "required_version must be a string literal")
+						continue
+					}
+					report.RequiredVersions = append(report.RequiredVersions, constraint)
+					constraints = append(constraints, versionConstraint{name, attribute.Line, constraint})
+				}
+			case block.Type == "provider":
+				for _, attributeName := range sortedKeys(block.Attributes) {
+					attribute := block.Attributes[attributeName]
+					if credentialAttributePattern.MatchString(attributeName) {
+						if value, ok := tfString(attribute.Expr); ok && value != "" {
+							report.addIssue(TemplateIssueSeverityError, TemplateRuleHardcodedCredential, name, attribute.Line,
+								"provider '%s' has a hard-coded value for '%s'; use a secure variable instead", strings.Join(block.Labels, "."), attributeName)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if major, minor, ok := terraformTemplateVersion(core.StringNilMapper(templateData.Type)); ok {
+		if len(constraints) == 0 {
+			report.addIssue(TemplateIssueSeverityWarning, TemplateRuleRequiredVersionMissing, "", 0, "the configuration does not declare a required_version")
+		}
+		for _, constraint := range constraints {
+			compatible, err := allowsMinorVersion(constraint.constraint, major, minor)
+			if err != nil {
+				report.addIssue(TemplateIssueSeverityError, TemplateRuleRequiredVersionInvalid, constraint.file, constraint.line, "%s", err.Error())
+			} else if !compatible {
+				report.addIssue(TemplateIssueSeverityError, TemplateRuleRequiredVersion, constraint.file, constraint.line,
+					"required_version '%s' does not allow Terraform %d.%d of template type '%s'", constraint.constraint, major, minor, *templateData.Type)
+			}
+		}
+	}
+
+	for _, variable := range templateData.Variablestore {
+		if name := core.StringNilMapper(variable.Name); !declared[name] {
+			report.addIssue(TemplateIssueSeverityError, TemplateRuleUndeclaredVariable, "", 0, "variable '%s' is set but not declared in folder '%s'", name, report.Folder)
+		}
+	}
+	return report
+}
+
+// terraformTemplateVersion returns the Terraform version of a template type such as "terraform_v1.5".
+func terraformTemplateVersion(templateType string) (major int, minor int, ok bool) {
+	match := terraformTemplateTypePattern.FindStringSubmatch(templateType)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, _ = strconv.Atoi(match[1])
+	minor, _ = strconv.Atoi(match[2])
+	return major, minor, true
+}
+
+// allowsMinorVersion reports whether a Terraform version constraint allows some patch release of major.minor.
+func allowsMinorVersion(constraint string, major int, minor int) (bool, error) {
+	var checks []func(version [3]int) bool
+	for _, part := range strings.Split(constraint, ",") {
+		check, err := parseVersionConstraint(strings.TrimSpace(part))
+		if err != nil {
+			return false, err
+		}
+		checks = append(checks, check)
+	}
+	for patch := 0; patch < 100; patch++ {
+		allowed := true
+		for _, check := range checks {
+			allowed = allowed && check([3]int{major, minor, patch})
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseVersionConstraint returns a check of a single version constraint. Pre-release constraints only match
+// versions of the same release.
+func parseVersionConstraint(constraint string) (func(version [3]int) bool, error) {
+	match := versionConstraintPattern.FindStringSubmatch(constraint)
+	if match == nil {
+		return nil, fmt.Errorf("invalid version constraint '%s'", constraint)
+	}
+	var target [3]int
+	segments := 1
+	for i := 0; i < 3; i++ {
+		if match[i+2] != "" {
+			target[i], _ = strconv.Atoi(match[i+2])
+			segments = i + 1
+		}
+	}
+	compare := func(version [3]int) int {
+		for i := 0; i < 3; i++ {
+			if version[i] != target[i] {
+				if version[i] < target[i] {
+					return -1
+				}
+				return 1
+			}
+		}
+		return 0
+	}
+	switch match[1] {
+	case "", "=":
+		return func(version [3]int) bool { return compare(version) == 0 }, nil
+	case "!=":
+		return func(version [3]int) bool { return compare(version) != 0 }, nil
+	case ">":
+		return func(version [3]int) bool { return compare(version) > 0 }, nil
+	case ">=":
+		return func(version [3]int) bool { return compare(version) >= 0 }, nil
+	case "<":
+		return func(version [3]int) bool { return compare(version) < 0 }, nil
+	case "<=":
+		return func(version [3]int) bool { return compare(version) <= 0 }, nil
+	}
+	// "~>" allows only the rightmost specified segment to increase.
+	if segments == 1 {
+		segments = 2
+	}
+	return func(version [3]int) bool {
+		if compare(version) < 0 {
+			return false
+		}
+		for i := 0; i < segments-1; i++ {
+			if version[i] != target[i] {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
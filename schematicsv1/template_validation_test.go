@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 template validation`, func() {
+	var dir string
+
+	const mainTf = `
+terraform {
+  required_version = ">= 1.3, < 2.0" # supported releases
+  required_providers {
+    ibm = {
+      source = "IBM-Cloud/ibm"
+    }
+  }
+}
+
+/* The provider reads its key
+   from a secure variable. */
+provider "ibm" {
+  ibmcloud_api_key = var.ibmcloud_api_key
+  region           = "us-south"
+}
+
+resource "ibm_is_vpc" "vpc" {
+  name = "${var.prefix}-vpc"
+  tags = [for tag in var.tags : lower(tag)]
+  user_data = <<-EOT
+    #!/bin/sh
+    echo "}"
+  EOT
+}
+`
+	const variablesTf = `
+variable "ibmcloud_api_key" {
+  type      = string
+  sensitive = true
+}
+variable "prefix" { default = "app" }
+variable "tags" {
+  type    = list(string)
+  default = ["a", "b"]
+}
+`
+
+	newTemplateData := func(folder string, templateType string, variables ...string) *schematicsv1.TemplateSourceDataRequest {
+		templateData := &schematicsv1.TemplateSourceDataRequest{Folder: core.StringPtr(folder), Type: core.StringPtr(templateType)}
+		for _, variable := range variables {
+			templateData.Variablestore = append(templateData.Variablestore, schematicsv1.WorkspaceVariableRequest{Name: core.StringPtr(variable)})
+		}
+		return templateData
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "template-validation")
+		Expect(err).To(BeNil())
+		writeFiles(dir, map[string]string{
+			"infra/main.tf":      mainTf,
+			"infra/variables.tf": variablesTf,
+			"docs/README.md":     "docs",
+		})
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Accept a valid template`, func() {
+		report, err := schematicsv1.ValidateTemplateDir(dir, newTemplateData("infra", "terraform_v1.5", "prefix", "tags", "ibmcloud_api_key"))
+		Expect(err).To(BeNil())
+		Expect(report.Issues).To(BeEmpty())
+		Expect(report.Valid()).To(BeTrue())
+		Expect(report.Folder).To(Equal("infra"))
+		Expect(report.Files).To(Equal([]string{"infra/main.tf", "infra/variables.tf"}))
+		Expect(report.RequiredVersions).To(Equal([]string{">= 1.3, < 2.0"}))
+		Expect(report.Variables).To(Equal([]string{"ibmcloud_api_key", "prefix", "tags"}))
+	})
+	It(`Report an incompatible version, undeclared variables and hard-coded credentials`, func() {
+		writeFiles(dir, map[string]string{"infra/main.tf": `
+terraform {
+  required_version = "~> 1.2.0"
+}
+provider "ibm" {
+  ibmcloud_api_key = "0123456789abcdef"
+  region           = "us-south"
+}
+`})
+		report, err := schematicsv1.ValidateTemplateDir(dir, newTemplateData("./infra/", "terraform_v1.5", "prefix", "zone"))
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeFalse())
+		Expect(report.Issues).To(Equal([]schematicsv1.TemplateValidationIssue{
+			{Severity: "error", Rule: schematicsv1.TemplateRuleHardcodedCredential, File: "infra/main.tf", Line: 6,
+				Message: "provider 'ibm' has a hard-coded value for 'ibmcloud_api_key'; use a secure variable instead"},
+			{Severity: "error", Rule: schematicsv1.TemplateRuleRequiredVersion, File: "infra/main.tf", Line: 3,
+				Message: "required_version '~> 1.2.0' does not allow Terraform 1.5 of template type 'terraform_v1.5'"},
+			{Severity: "error", Rule: schematicsv1.TemplateRuleUndeclaredVariable,
+				Message: "variable 'zone' is set but not declared in folder 'infra'"},
+		}))
+	})
+	It(`Check version constraints`, func() {
+		for constraint, valid := range map[string]bool{
+			"1.5.7":          true,
+			"= 1.4.0":        false,
+			">= 1.6":         false,
+			"> 1.5.2":        true,
+			"<= 1.5.0":       true,
+			"< 1.5":          false,
+			"!= 1.5.0":       true,
+			"~> 1.5":         true,
+			"~> 1.4":         true,
+			"~> 1.4.0":       false,
+			"~> 0.14":        false,
+			">= 1.0, < 1.5":  false,
+			">= 1.0, < 1.6":  true,
+			"1.5.0-beta1":    true,
+			"not a version":  false,
+			">= 1.0, banana": false,
+		} {
+			writeFiles(dir, map[string]string{"infra/main.tf": `terraform { required_version = "` + constraint + `" }`})
+			report, err := schematicsv1.ValidateTemplateDir(dir, newTemplateData("infra", "terraform_v1.5"))
+			Expect(err).To(BeNil())
+			Expect(report.Valid()).To(Equal(valid), constraint)
+		}
+	})
+	It(`Warn about a missing required_version and skip it for other template types`, func() {
+		writeFiles(dir, map[string]string{"infra/main.tf": `resource "null_resource" "x" {}`})
+		report, err := schematicsv1.ValidateTemplateDir(dir, newTemplateData("infra", "terraform_v1.5"))
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeTrue())
+		Expect(report.Issues).To(HaveLen(1))
+		Expect(report.Issues[0].Rule).To(Equal(schematicsv1.TemplateRuleRequiredVersionMissing))
+
+		report, err = schematicsv1.ValidateTemplateDir(dir, newTemplateData("infra", "ansible"))
+		Expect(err).To(BeNil())
+		Expect(report.Issues).To(BeEmpty())
+	})
+	It(`Report missing folders, folders without configuration and syntax errors`, func() {
+		report, err := schematicsv1.ValidateTemplateDir(dir, newTemplateData("network", ""))
+		Expect(err).To(BeNil())
+		Expect(report.Errors()).To(HaveLen(1))
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.TemplateRuleFolderMissing))
+
+		report, err = schematicsv1.ValidateTemplateDir(dir, newTemplateData("docs", ""))
+		Expect(err).To(BeNil())
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.TemplateRuleNoTerraformFiles))
+
+		report, err = schematicsv1.ValidateTemplateDir(dir, nil)
+		Expect(err).To(BeNil())
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.TemplateRuleNoTerraformFiles))
+
+		writeFiles(dir, map[string]string{"infra/broken.tf": "resource \"x\" \"y\" {\n  name = \"unterminated\n}\n"})
+		report, err = schematicsv1.ValidateTemplateDir(dir, newTemplateData("infra", ""))
+		Expect(err).To(BeNil())
+		Expect(report.Errors()).To(HaveLen(1))
+		Expect(report.Errors()[0].Rule).To(Equal(schematicsv1.TemplateRuleSyntax))
+		Expect(report.Errors()[0].File).To(Equal("infra/broken.tf"))
+	})
+	It(`Validate a template archive`, func() {
+		archive := &bytes.Buffer{}
+		_, err := schematicsv1.NewTemplateArchive(dir).WriteTo(archive)
+		Expect(err).To(BeNil())
+
+		report, err := schematicsv1.ValidateTemplateArchive(archive, newTemplateData("infra", "terraform_v1.5", "prefix"))
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeTrue())
+		Expect(report.Files).To(HaveLen(2))
+
+		_, err = schematicsv1.ValidateTemplateArchive(bytes.NewReader([]byte("not a tar file at all")), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
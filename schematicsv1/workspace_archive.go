@@ -0,0 +1,532 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// WorkspaceArchiveFormatVersion is the version of the archive layout written by ExportWorkspace.
+const WorkspaceArchiveFormatVersion = 1
+
+// Formats of workspace archives.
+const (
+	WorkspaceArchiveFormatTarGz = "tar.gz"
+	WorkspaceArchiveFormatZip   = "zip"
+)
+
+// The files of a workspace archive. Per-template files are stored under templates/<template ID>/.
+const (
+	workspaceArchiveManifest   = "manifest.json"
+	workspaceArchiveWorkspace  = "workspace.json"
+	workspaceArchiveInputs     = "inputs.json"
+	workspaceArchiveOutputs    = "outputs.json"
+	workspaceArchiveActivities = "activities.json"
+	workspaceArchiveReadme     = "README.md"
+	workspaceArchiveState      = "state.json"
+	workspaceArchiveTemplate   = "template.tar"
+)
+
+// DefaultMaxWorkspaceArchiveSize is the maximum size of a workspace archive, and of the files that it contains
+// in total, if WorkspaceImportOptions.MaxArchiveSize is not set.
+const DefaultMaxWorkspaceArchiveSize = 1 << 30
+
+// WorkspaceExportOptions : the ExportWorkspace options.
+type WorkspaceExportOptions struct {
+	// The archive format, WorkspaceArchiveFormatTarGz (the default) or WorkspaceArchiveFormatZip.
+	Format string
+
+	// The template archives (tar files, as uploaded with TemplateRepoUpload) to include, keyed by template ID.
+	// Schematics does not provide a way to download uploaded templates, so they must be supplied to be restored.
+	Templates map[string]io.Reader
+
+	// Custom request headers added to every operation.
+	Headers map[string]string
+}
+
+// WorkspaceArchiveManifest : the description of the contents of a workspace archive.
+type WorkspaceArchiveManifest struct {
+	FormatVersion int       `json:"format_version"`
+	SdkVersion    string    `json:"sdk_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	WorkspaceID   string    `json:"workspace_id"`
+	WorkspaceName string    `json:"workspace_name"`
+
+	// The templates of the workspace, in the order of the workspace's template data.
+	Templates []WorkspaceArchiveTemplate `json:"templates"`
+
+	// The secure variables and environment values whose values are not in the archive, as "<template ID>/<name>".
+	// Schematics does not return secure values, so they must be supplied on import.
+	OmittedSecureValues []string `json:"omitted_secure_values,omitempty"`
+
+	// Parts of the workspace that could not be exported.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// WorkspaceArchiveTemplate : a template in a workspace archive.
+type WorkspaceArchiveTemplate struct {
+	ID string `json:"id"`
+
+	// True if the archive contains the template's state.
+	HasState bool `json:"has_state"`
+
+	// True if the archive contains the template's tar file.
+	HasTemplate bool `json:"has_template"`
+}
+
+// WorkspaceImportOptions : the ImportWorkspace options.
+type WorkspaceImportOptions struct {
+	// The name of the new workspace. Defaults to the name of the exported workspace.
+	Name string
+
+	// The resource group of the new workspace. Defaults to the resource group of the exported workspace.
+	ResourceGroup string
+
+	// The location of the new workspace. Defaults to the location of the exported workspace.
+	Location string
+
+	// Values of template variables, by variable name. They are set in every template that declares the variable
+	// and are the only way to give secure variables a value.
+	Variables map[string]string
+
+	// The maximum size of the archive, and of the files that it contains in total; DefaultMaxWorkspaceArchiveSize
+	// if 0.
+	MaxArchiveSize int64
+
+	// If true, the exported template state is used as the initial state of each template of the new workspace.
+	RestoreState bool
+
+	// The personal access token used to read the workspace's Git repositories.
+	XGithubToken string
+
+	// Custom request headers added to every operation.
+	Headers map[string]string
+}
+
+// WorkspaceImportResult : the result of ImportWorkspace.
+type WorkspaceImportResult struct {
+	// The new workspace.
+	Workspace *WorkspaceResponse
+
+	// The manifest of the imported archive.
+	Manifest *WorkspaceArchiveManifest
+
+	// The IDs of the new workspace's templates whose tar files were uploaded.
+	UploadedTemplates []string
+
+	// The secure variables and environment values of the new workspace that have no value, as
+	// "<template ID>/<name>" with the exported template ID. Schematics does not return secure values, so they are never
+	// archived and must be set with WorkspaceImportOptions.Variables or after importing.
+	MissingSecureValues []string
+}
+
+// ExportWorkspace writes a portable archive of a workspace to writer. The archive contains the workspace
+// definition, its inputs, its outputs, the state of each template, the README and the activity history, as JSON
+// files described by manifest.json. The README, outputs and state are left out with a warning if they cannot be
+// read, for example because the workspace was never applied. Schematics does not return the values of secure
+// variables and environment values, so they are left out and listed in the manifest's OmittedSecureValues.
+func (schematics *SchematicsV1) ExportWorkspace(ctx context.Context, wID string, writer io.Writer, options *WorkspaceExportOptions) (manifest *WorkspaceArchiveManifest, err error) {
+	if options == nil {
+		options = &WorkspaceExportOptions{}
+	}
+	archive, err := newWorkspaceArchiveWriter(writer, options.Format)
+	if err != nil {
+		return
+	}
+
+	workspace, _, err := schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &wID, Headers: options.Headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "workspace-export-error")
+		return
+	}
+	inputs, _, err := schematics.GetAllWorkspaceInputsWithContext(ctx, &GetAllWorkspaceInputsOptions{WID: &wID, Headers: options.Headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "workspace-export-error")
+		return
+	}
+	activities, _, err := schematics.ListWorkspaceActivitiesWithContext(ctx, &ListWorkspaceActivitiesOptions{WID: &wID, Headers: options.Headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "workspace-export-error")
+		return
+	}
+
+	manifest = &WorkspaceArchiveManifest{
+		FormatVersion:       WorkspaceArchiveFormatVersion,
+		SdkVersion:          common.Version,
+		ExportedAt:          time.Now().UTC(),
+		WorkspaceID:         wID,
+		WorkspaceName:       core.StringNilMapper(workspace.Name),
+		OmittedSecureValues: omitSecureValues(inputs),
+	}
+
+	files := map[string]interface{}{
+		workspaceArchiveWorkspace:  workspace,
+		workspaceArchiveInputs:     inputs,
+		workspaceArchiveActivities: activities,
+	}
+	outputs, _, outputsErr := schematics.GetWorkspaceOutputsWithContext(ctx, &GetWorkspaceOutputsOptions{WID: &wID, Headers: options.Headers})
+	if outputsErr != nil {
+		manifest.Warnings = append(manifest.Warnings, fmt.Sprintf("outputs: %s", outputsErr.Error()))
+	} else {
+		files[workspaceArchiveOutputs] = outputs
+	}
+
+	for _, template := range workspace.TemplateData {
+		tID := core.StringNilMapper(template.ID)
+		archived := WorkspaceArchiveTemplate{ID: tID}
+		state, stateErr := schematics.getWorkspaceTemplateStateJSON(ctx, wID, tID, options.Headers)
+		if stateErr != nil {
+			manifest.Warnings = append(manifest.Warnings, fmt.Sprintf("state of template %s: %s", tID, stateErr.Error()))
+		} else if len(state) > 0 {
+			files[path.Join("templates", tID, workspaceArchiveState)] = state
+			archived.HasState = true
+		}
+		if reader, ok := options.Templates[tID]; ok {
+			var data []byte
+			if data, err = io.ReadAll(reader); err != nil {
+				err = core.SDKErrorf(err, "", "workspace-export-error", common.GetComponentInfo())
+				return
+			}
+			if err = archive.add(path.Join("templates", tID, workspaceArchiveTemplate), data); err != nil {
+				return
+			}
+			archived.HasTemplate = true
+		}
+		manifest.Templates = append(manifest.Templates, archived)
+	}
+
+	readme, _, readmeErr := schematics.GetWorkspaceReadmeWithContext(ctx, &GetWorkspaceReadmeOptions{WID: &wID, Headers: options.Headers})
+	if readmeErr != nil {
+		manifest.Warnings = append(manifest.Warnings, fmt.Sprintf("readme: %s", readmeErr.Error()))
+	} else if readme.Readme != nil {
+		if err = archive.add(workspaceArchiveReadme, []byte(*readme.Readme)); err != nil {
+			return
+		}
+	}
+
+	files[workspaceArchiveManifest] = manifest
+	for _, name := range sortedKeys(files) {
+		if err = archive.addJSON(name, files[name]); err != nil {
+			return
+		}
+	}
+	err = archive.close()
+	return
+}
+
+// getWorkspaceTemplateStateJSON returns the state of a workspace template as the JSON document that Schematics
+// returns. Unlike TemplateStateStore, it keeps the resources and outputs of the state format of Terraform 0.12 and
+// later, which a restored state must not lose.
+func (schematics *SchematicsV1) getWorkspaceTemplateStateJSON(ctx context.Context, wID string, tID string, headers map[string]string) (state json.RawMessage, err error) {
+	options := &GetWorkspaceTemplateStateOptions{WID: &wID, TID: &tID, Headers: headers}
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = schematics.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(schematics.Service.Options.URL, `/v1/workspaces/{w_id}/runtime_data/{t_id}/state_store`, map[string]string{"w_id": wID, "t_id": tID})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	sdkHeaders := common.GetSdkHeadersForRequest("schematics", "V1", "GetWorkspaceTemplateState", headers)
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	_, err = schematics.invoke(ctx, "GetWorkspaceTemplateState", options, request, &state, func(request *http.Request) (response *core.DetailedResponse, err error) {
+		response, err = schematics.Service.Request(request, &state)
+		if err != nil {
+			core.EnrichHTTPProblem(err, "get_workspace_template_state", getServiceComponentInfo())
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		}
+		return
+	})
+	return
+}
+
+// ImportWorkspace creates a workspace from an archive written by ExportWorkspace, optionally with a different
+// name, resource group or location, and uploads the template tar files that the archive contains. The secure
+// variables of the new workspace only have a value if it is set with options.Variables; those without a value are
+// listed in the result's MissingSecureValues.
+func (schematics *SchematicsV1) ImportWorkspace(ctx context.Context, reader io.Reader, options *WorkspaceImportOptions) (result *WorkspaceImportResult, err error) {
+	if options == nil {
+		options = &WorkspaceImportOptions{}
+	}
+	maxSize := options.MaxArchiveSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxWorkspaceArchiveSize
+	}
+	files, err := readWorkspaceArchive(reader, maxSize)
+	if err != nil {
+		return
+	}
+
+	result = &WorkspaceImportResult{Manifest: &WorkspaceArchiveManifest{}}
+	workspace := &WorkspaceResponse{}
+	inputs := &WorkspaceTemplateValuesResponse{}
+	for name, target := range map[string]interface{}{workspaceArchiveManifest: result.Manifest, workspaceArchiveWorkspace: workspace, workspaceArchiveInputs: inputs} {
+		data, ok := files[name]
+		if !ok {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("the archive does not contain %s", name), "workspace-import-error", common.GetComponentInfo())
+		}
+		if err = json.Unmarshal(data, target); err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("the archive contains an invalid %s", name), "workspace-import-error", common.GetComponentInfo())
+		}
+	}
+	if result.Manifest.FormatVersion > WorkspaceArchiveFormatVersion {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported archive format version %d", result.Manifest.FormatVersion), "workspace-import-error", common.GetComponentInfo())
+	}
+	// Secure values are never archived, so only WorkspaceImportOptions.Variables can set them.
+	omitSecureValues(inputs)
+	assigned := map[string]bool{}
+	for i := range inputs.TemplateData {
+		template := &inputs.TemplateData[i]
+		tID := core.StringNilMapper(template.ID)
+		for j := range template.Variablestore {
+			variable := &template.Variablestore[j]
+			name := core.StringNilMapper(variable.Name)
+			if value, ok := options.Variables[name]; ok {
+				variable.Value = core.StringPtr(value)
+				assigned[name] = true
+			} else if variable.Secure != nil && *variable.Secure {
+				result.MissingSecureValues = append(result.MissingSecureValues, tID+"/"+name)
+			}
+		}
+		for _, env := range template.EnvValues {
+			if env.Secure != nil && *env.Secure {
+				result.MissingSecureValues = append(result.MissingSecureValues, tID+"/"+core.StringNilMapper(env.Name))
+			}
+		}
+	}
+	for name := range options.Variables {
+		if !assigned[name] {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("no template of the archived workspace declares variable '%s'", name), "workspace-import-unknown-variable", common.GetComponentInfo())
+		}
+	}
+
+	createWorkspaceOptions := schematics.newWorkspaceCreateOptions(workspace, inputs.TemplateData)
+	if options.RestoreState {
+		for i := range createWorkspaceOptions.TemplateData {
+			if i < len(result.Manifest.Templates) {
+				if state, ok := files[path.Join("templates", result.Manifest.Templates[i].ID, workspaceArchiveState)]; ok {
+					createWorkspaceOptions.TemplateData[i].InitStateFile = core.StringPtr(string(state))
+				}
+			}
+		}
+	}
+	if options.Name != "" {
+		createWorkspaceOptions.Name = &options.Name
+	}
+	if options.ResourceGroup != "" {
+		createWorkspaceOptions.ResourceGroup = &options.ResourceGroup
+	}
+	if options.Location != "" {
+		createWorkspaceOptions.Location = &options.Location
+	}
+	if options.XGithubToken != "" {
+		createWorkspaceOptions.XGithubToken = &options.XGithubToken
+	}
+	createWorkspaceOptions.Headers = options.Headers
+
+	result.Workspace, _, err = schematics.CreateWorkspaceWithContext(ctx, createWorkspaceOptions)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "workspace-import-error")
+	}
+
+	for i, template := range result.Manifest.Templates {
+		data, ok := files[path.Join("templates", template.ID, workspaceArchiveTemplate)]
+		if !ok {
+			continue
+		}
+		if i >= len(result.Workspace.TemplateData) || result.Workspace.TemplateData[i].ID == nil {
+			return result, core.SDKErrorf(nil, fmt.Sprintf("the new workspace has no template to upload template %s to", template.ID), "workspace-import-error", common.GetComponentInfo())
+		}
+		tID := *result.Workspace.TemplateData[i].ID
+		templateRepoUploadOptions := schematics.NewTemplateRepoUploadOptions(*result.Workspace.ID, tID)
+		templateRepoUploadOptions.SetFile(io.NopCloser(bytes.NewReader(data)))
+		templateRepoUploadOptions.SetFileContentType("application/octet-stream")
+		templateRepoUploadOptions.Headers = options.Headers
+		if _, _, err = schematics.TemplateRepoUploadWithContext(ctx, templateRepoUploadOptions); err != nil {
+			return result, core.RepurposeSDKProblem(err, "workspace-import-error")
+		}
+		result.UploadedTemplates = append(result.UploadedTemplates, tID)
+	}
+	return result, nil
+}
+
+// omitSecureValues removes the values of the secure variables and environment values of inputs and returns their
+// names.
+func omitSecureValues(inputs *WorkspaceTemplateValuesResponse) (omitted []string) {
+	for i := range inputs.TemplateData {
+		template := &inputs.TemplateData[i]
+		tID := core.StringNilMapper(template.ID)
+		for j := range template.Variablestore {
+			variable := &template.Variablestore[j]
+			if variable.Secure != nil && *variable.Secure {
+				omitted = append(omitted, tID+"/"+core.StringNilMapper(variable.Name))
+				variable.Value = nil
+			}
+		}
+		for j := range template.EnvValues {
+			env := &template.EnvValues[j]
+			if env.Secure != nil && *env.Secure {
+				omitted = append(omitted, tID+"/"+core.StringNilMapper(env.Name))
+				env.Value = nil
+			}
+		}
+	}
+	return omitted
+}
+
+// workspaceArchiveWriter writes the files of a workspace archive in either format.
+type workspaceArchiveWriter struct {
+	gzip *gzip.Writer
+	tar  *tar.Writer
+	zip  *zip.Writer
+}
+
+func newWorkspaceArchiveWriter(writer io.Writer, format string) (*workspaceArchiveWriter, error) {
+	switch format {
+	case "", WorkspaceArchiveFormatTarGz:
+		gzipWriter := gzip.NewWriter(writer)
+		return &workspaceArchiveWriter{gzip: gzipWriter, tar: tar.NewWriter(gzipWriter)}, nil
+	case WorkspaceArchiveFormatZip:
+		return &workspaceArchiveWriter{zip: zip.NewWriter(writer)}, nil
+	}
+	return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported archive format '%s'", format), "workspace-archive-format-error", common.GetComponentInfo())
+}
+
+func (archive *workspaceArchiveWriter) addJSON(name string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "workspace-archive-write-error", common.GetComponentInfo())
+	}
+	return archive.add(name, data)
+}
+
+func (archive *workspaceArchiveWriter) add(name string, data []byte) (err error) {
+	if archive.zip != nil {
+		var file io.Writer
+		if file, err = archive.zip.Create(name); err == nil {
+			_, err = file.Write(data)
+		}
+	} else {
+		err = archive.tar.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: time.Now()})
+		if err == nil {
+			_, err = archive.tar.Write(data)
+		}
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "workspace-archive-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (archive *workspaceArchiveWriter) close() (err error) {
+	if archive.zip != nil {
+		err = archive.zip.Close()
+	} else if err = archive.tar.Close(); err == nil {
+		err = archive.gzip.Close()
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "workspace-archive-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// readWorkspaceArchive reads the files of a workspace archive in either format, detected from its content. Both the
+// archive and the files that it contains in total are limited to maxSize bytes.
+func readWorkspaceArchive(reader io.Reader, maxSize int64) (map[string][]byte, error) {
+	archiveRemaining := maxSize
+	data, err := readWorkspaceArchiveData(reader, &archiveRemaining)
+	if err != nil {
+		return nil, err
+	}
+	remaining := maxSize
+
+	files := map[string][]byte{}
+	if bytes.HasPrefix(data, []byte("PK")) {
+		zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "workspace-archive-read-error", common.GetComponentInfo())
+		}
+		for _, file := range zipReader.File {
+			content, err := file.Open()
+			if err != nil {
+				return nil, core.SDKErrorf(err, "", "workspace-archive-read-error", common.GetComponentInfo())
+			}
+			files[file.Name], err = readWorkspaceArchiveData(content, &remaining)
+			content.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return files, nil
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "the archive is neither a zip nor a gzipped tar file", "workspace-archive-read-error", common.GetComponentInfo())
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "workspace-archive-read-error", common.GetComponentInfo())
+		}
+		if files[header.Name], err = readWorkspaceArchiveData(tarReader, &remaining); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readWorkspaceArchiveData reads at most *remaining bytes from reader, and subtracts the bytes read from *remaining.
+func readWorkspaceArchiveData(reader io.Reader, remaining *int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, *remaining+1))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "workspace-archive-read-error", common.GetComponentInfo())
+	}
+	if int64(len(data)) > *remaining {
+		return nil, core.SDKErrorf(nil, "the workspace archive exceeds the maximum archive size", "workspace-archive-size-error", common.GetComponentInfo())
+	}
+	*remaining -= int64(len(data))
+	return data, nil
+}
@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 workspace archives`, func() {
+	var testServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var created map[string]interface{}
+	var uploads map[string]string
+
+	const inputs = `{"template_data": [{"id": "t1", "folder": ".", "type": "terraform_v1.5",
+		"variablestore": [{"name": "region", "value": "us-south"}, {"name": "api_key", "secure": true, "value": "s3cr3t"}],
+		"env_values": [{"name": "TF_LOG", "value": "debug"}, {"name": "TOKEN", "secure": true, "value": "t0k3n"}]}]}`
+	const state = `{"version": 4, "terraform_version": "1.5.7", "serial": 7, "lineage": "abc",
+		"outputs": {"ip": {"value": "10.0.0.1", "type": "string"}},
+		"resources": [{"mode": "managed", "type": "ibm_is_vpc", "name": "vpc", "provider": "provider[\"registry.terraform.io/ibm-cloud/ibm\"]",
+			"instances": [{"schema_version": 0, "attributes": {"id": "r006-1", "name": "vpc"}}]}]}`
+
+	BeforeEach(func() {
+		created = nil
+		uploads = map[string]string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == "/v1/workspaces/w1":
+				fmt.Fprint(res, `{"id": "w1", "name": "app", "resource_group": "default", "location": "us-south",
+					"description": "demo", "tags": ["env:prod"], "type": ["terraform_v1.5"],
+					"template_data": [{"id": "t1", "folder": ".", "type": "terraform_v1.5"}],
+					"workspace_status": {"frozen": true}}`)
+			case req.URL.Path == "/v1/workspaces/w1/templates/values":
+				fmt.Fprint(res, inputs)
+			case req.URL.Path == "/v1/workspaces/w1/runtime_data/t1/state_store":
+				fmt.Fprint(res, state)
+			case req.URL.Path == "/v1/workspaces/w1/output_values":
+				fmt.Fprint(res, `[{"id": "t1", "output_values": [{"ip": "10.0.0.1"}]}]`)
+			case req.URL.Path == "/v1/workspaces/w1/templates/readme":
+				res.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(res, `{"errors": [{"message": "no readme"}]}`)
+			case req.URL.Path == "/v1/workspaces/w1/actions":
+				fmt.Fprint(res, `{"workspace_id": "w1", "actions": [{"action_id": "a1", "name": "APPLY"}]}`)
+			case req.Method == http.MethodPost && req.URL.Path == "/v1/workspaces":
+				Expect(json.NewDecoder(req.Body).Decode(&created)).To(Succeed())
+				fmt.Fprint(res, `{"id": "w2", "template_data": [{"id": "t2"}]}`)
+			case strings.HasSuffix(req.URL.Path, "/template_repo_upload"):
+				file, _, err := req.FormFile("file")
+				Expect(err).To(BeNil())
+				data, _ := io.ReadAll(file)
+				uploads[req.URL.Path] = string(data)
+				fmt.Fprint(res, `{"has_received_file": true}`)
+			default:
+				res.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	templateData := func() map[string]interface{} {
+		return created["template_data"].([]interface{})[0].(map[string]interface{})
+	}
+
+	for _, format := range []string{schematicsv1.WorkspaceArchiveFormatTarGz, schematicsv1.WorkspaceArchiveFormatZip} {
+		format := format
+		It(fmt.Sprintf(`Export and import a workspace as %s`, format), func() {
+			archive := &bytes.Buffer{}
+			manifest, err := schematicsService.ExportWorkspace(context.Background(), "w1", archive, &schematicsv1.WorkspaceExportOptions{
+				Format:    format,
+				Templates: map[string]io.Reader{"t1": strings.NewReader("template tar")},
+			})
+			Expect(err).To(BeNil())
+			Expect(manifest.WorkspaceName).To(Equal("app"))
+			Expect(manifest.OmittedSecureValues).To(Equal([]string{"t1/api_key", "t1/TOKEN"}))
+			Expect(manifest.Templates).To(Equal([]schematicsv1.WorkspaceArchiveTemplate{{ID: "t1", HasState: true, HasTemplate: true}}))
+			Expect(manifest.Warnings).To(HaveLen(1))
+			Expect(manifest.Warnings[0]).To(HavePrefix("readme: "))
+			Expect(archive.String()).ToNot(ContainSubstring("s3cr3t"))
+
+			result, err := schematicsService.ImportWorkspace(context.Background(), bytes.NewReader(archive.Bytes()), &schematicsv1.WorkspaceImportOptions{
+				Name:          "app-copy",
+				ResourceGroup: "other",
+				Variables:     map[string]string{"api_key": "new-key"},
+				RestoreState:  true,
+			})
+			Expect(err).To(BeNil())
+			Expect(*result.Workspace.ID).To(Equal("w2"))
+			Expect(result.UploadedTemplates).To(Equal([]string{"t2"}))
+			Expect(result.MissingSecureValues).To(Equal([]string{"t1/TOKEN"}))
+			Expect(uploads).To(Equal(map[string]string{"/v1/workspaces/w2/template_data/t2/template_repo_upload": "template tar"}))
+
+			Expect(created["name"]).To(Equal("app-copy"))
+			Expect(created["resource_group"]).To(Equal("other"))
+			Expect(created["location"]).To(Equal("us-south"))
+			Expect(created["description"]).To(Equal("demo"))
+			Expect(created).ToNot(HaveKey("workspace_status"))
+			template := templateData()
+			Expect(template["variablestore"]).To(ConsistOf(
+				map[string]interface{}{"name": "region", "value": "us-south"},
+				map[string]interface{}{"name": "api_key", "secure": true, "value": "new-key"},
+			))
+			Expect(template["env_values"]).To(ConsistOf(map[string]interface{}{"TF_LOG": "debug"}))
+			Expect(template["init_state_file"]).To(ContainSubstring(`"lineage": "abc"`))
+		})
+	}
+	It(`Leave secure values without a value unless they are supplied`, func() {
+		archive := &bytes.Buffer{}
+		manifest, err := schematicsService.ExportWorkspace(context.Background(), "w1", archive, nil)
+		Expect(err).To(BeNil())
+		Expect(manifest.OmittedSecureValues).To(Equal([]string{"t1/api_key", "t1/TOKEN"}))
+
+		_, err = schematicsService.ImportWorkspace(context.Background(), bytes.NewReader(archive.Bytes()), &schematicsv1.WorkspaceImportOptions{
+			Variables: map[string]string{"unknown": "x"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("no template of the archived workspace declares variable 'unknown'"))
+		Expect(created).To(BeNil())
+
+		result, err := schematicsService.ImportWorkspace(context.Background(), bytes.NewReader(archive.Bytes()), nil)
+		Expect(err).To(BeNil())
+		Expect(result.MissingSecureValues).To(Equal([]string{"t1/api_key", "t1/TOKEN"}))
+		Expect(result.UploadedTemplates).To(BeEmpty())
+		Expect(created["name"]).To(Equal("app"))
+		Expect(templateData()["variablestore"]).To(ConsistOf(
+			map[string]interface{}{"name": "region", "value": "us-south"},
+			map[string]interface{}{"name": "api_key", "secure": true},
+		))
+		Expect(templateData()).ToNot(HaveKey("init_state_file"))
+	})
+	It(`Restore a Terraform 0.12 or later state with its resources and outputs`, func() {
+		archive := &bytes.Buffer{}
+		_, err := schematicsService.ExportWorkspace(context.Background(), "w1", archive, nil)
+		Expect(err).To(BeNil())
+
+		_, err = schematicsService.ImportWorkspace(context.Background(), bytes.NewReader(archive.Bytes()), &schematicsv1.WorkspaceImportOptions{RestoreState: true})
+		Expect(err).To(BeNil())
+		restored, ok := templateData()["init_state_file"].(string)
+		Expect(ok).To(BeTrue())
+		Expect(restored).To(MatchJSON(state))
+	})
+	It(`Reject invalid and oversized archives`, func() {
+		archive := &bytes.Buffer{}
+		_, err := schematicsService.ExportWorkspace(context.Background(), "w1", archive, &schematicsv1.WorkspaceExportOptions{Format: schematicsv1.WorkspaceArchiveFormatZip})
+		Expect(err).To(BeNil())
+
+		_, err = schematicsService.ImportWorkspace(context.Background(), bytes.NewReader(archive.Bytes()), &schematicsv1.WorkspaceImportOptions{MaxArchiveSize: 100})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("the workspace archive exceeds the maximum archive size"))
+		Expect(created).To(BeNil())
+
+		_, err = schematicsService.ImportWorkspace(context.Background(), strings.NewReader("not an archive"), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = schematicsService.ExportWorkspace(context.Background(), "w1", archive, &schematicsv1.WorkspaceExportOptions{Format: "rar"})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/schematics-go-sdk/common"
+)
+
+// WorkspaceCloneOptions : the CloneWorkspace options. Unset fields keep the values of the source workspace.
+type WorkspaceCloneOptions struct {
+	// The name of the clone.
+	Name string
+
+	// The location of the clone. A location served by a different regional endpoint also requires Target.
+	Location string
+
+	// The resource group of the clone.
+	ResourceGroup string
+
+	// The tags of the clone. A non-nil empty slice clears the tags.
+	Tags []string
+
+	// The ID of the agent that runs the jobs of the clone.
+	AgentID string
+
+	// The Git branch of the clone's template repository. Setting it also drops the source's pinned commit.
+	Branch string
+
+	// Values of template variables, by variable name. They are set in every template that declares the variable
+	// and are the only way to give secure variables a value.
+	Variables map[string]string
+
+	// The service that creates the clone, e.g. a service for the clone's location. Defaults to the service that
+	// reads the source workspace.
+	Target *SchematicsV1
+
+	// The personal access token used to read the workspace's Git repositories.
+	XGithubToken string
+
+	// Custom request headers added to every operation.
+	Headers map[string]string
+}
+
+// WorkspaceCloneResult : the result of CloneWorkspace.
+type WorkspaceCloneResult struct {
+	// The new workspace.
+	Workspace *WorkspaceResponse
+
+	// The secure variables and environment values that have no value in the clone, as "<template ID>/<name>" with
+	// the source template ID. Schematics does not return secure values, so they are never copied and must be set with
+	// WorkspaceCloneOptions.Variables or after cloning.
+	UncopiedSecureValues []string
+
+	// The IDs of the clone's templates that need a TemplateRepoUpload because the source template was uploaded
+	// rather than read from a Git repository.
+	PendingTemplateUploads []string
+}
+
+// CloneWorkspace creates a copy of a workspace, including its template repository, template variables,
+// environment values, shared data and agent, with the specified overrides.
+func (schematics *SchematicsV1) CloneWorkspace(ctx context.Context, srcID string, options *WorkspaceCloneOptions) (result *WorkspaceCloneResult, err error) {
+	if options == nil {
+		options = &WorkspaceCloneOptions{}
+	}
+	source, _, err := schematics.GetWorkspaceWithContext(ctx, &GetWorkspaceOptions{WID: &srcID, Headers: options.Headers})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "workspace-clone-error")
+	}
+
+	result = &WorkspaceCloneResult{}
+	templateData := make([]TemplateSourceDataResponse, len(source.TemplateData))
+	assigned := map[string]bool{}
+	for i, template := range source.TemplateData {
+		tID := core.StringNilMapper(template.ID)
+		template.Variablestore = append([]WorkspaceVariableResponse(nil), template.Variablestore...)
+		for j := range template.Variablestore {
+			variable := &template.Variablestore[j]
+			name := core.StringNilMapper(variable.Name)
+			if value, ok := options.Variables[name]; ok {
+				variable.Value = core.StringPtr(value)
+				assigned[name] = true
+			} else if variable.Secure != nil && *variable.Secure {
+				variable.Value = nil
+				result.UncopiedSecureValues = append(result.UncopiedSecureValues, tID+"/"+name)
+			}
+		}
+		template.EnvValues = append([]EnvVariableResponse(nil), template.EnvValues...)
+		for j := range template.EnvValues {
+			env := &template.EnvValues[j]
+			if env.Secure != nil && *env.Secure {
+				env.Value = nil
+				result.UncopiedSecureValues = append(result.UncopiedSecureValues, tID+"/"+core.StringNilMapper(env.Name))
+			}
+		}
+		templateData[i] = template
+	}
+	for name := range options.Variables {
+		if !assigned[name] {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("no template of workspace %s declares variable '%s'", srcID, name), "workspace-clone-unknown-variable", common.GetComponentInfo())
+		}
+	}
+
+	createWorkspaceOptions := schematics.newWorkspaceCreateOptions(source, templateData)
+	if options.Name != "" {
+		createWorkspaceOptions.Name = &options.Name
+	}
+	if options.Location != "" {
+		createWorkspaceOptions.Location = &options.Location
+	}
+	if options.ResourceGroup != "" {
+		createWorkspaceOptions.ResourceGroup = &options.ResourceGroup
+	}
+	if options.Tags != nil {
+		createWorkspaceOptions.Tags = options.Tags
+	}
+	if options.AgentID != "" {
+		createWorkspaceOptions.AgentID = &options.AgentID
+	}
+	if options.Branch != "" {
+		if createWorkspaceOptions.TemplateRepo == nil {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("workspace %s has no template repository to set the branch of", srcID), "workspace-clone-error", common.GetComponentInfo())
+		}
+		createWorkspaceOptions.TemplateRepo.Branch = &options.Branch
+		createWorkspaceOptions.TemplateRepo.RepoShaValue = nil
+	}
+	if options.XGithubToken != "" {
+		createWorkspaceOptions.XGithubToken = &options.XGithubToken
+	}
+	createWorkspaceOptions.Headers = options.Headers
+
+	target := options.Target
+	if target == nil {
+		target = schematics
+	}
+	result.Workspace, _, err = target.CreateWorkspaceWithContext(ctx, createWorkspaceOptions)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "workspace-clone-error")
+	}
+
+	if source.TemplateRepo != nil && source.TemplateRepo.HasUploadedgitrepotar != nil && *source.TemplateRepo.HasUploadedgitrepotar {
+		for _, template := range result.Workspace.TemplateData {
+			result.PendingTemplateUploads = append(result.PendingTemplateUploads, core.StringNilMapper(template.ID))
+		}
+	}
+	return result, nil
+}
+
+// newWorkspaceCreateOptions returns the options that create a copy of workspace with the specified template
+// data. Properties that only the service sets, such as the workspace status, are left out.
+func (schematics *SchematicsV1) newWorkspaceCreateOptions(workspace *WorkspaceResponse, templateData []TemplateSourceDataResponse) *CreateWorkspaceOptions {
+	createWorkspaceOptions := &CreateWorkspaceOptions{
+		AppliedShareddataIds: append([]string(nil), workspace.AppliedShareddataIds...),
+		CatalogRef:           workspace.CatalogRef,
+		Dependencies:         workspace.Dependencies,
+		Description:          workspace.Description,
+		Location:             workspace.Location,
+		Name:                 workspace.Name,
+		ResourceGroup:        workspace.ResourceGroup,
+		SharedData:           schematics.NewSharedTargetDataFromResponse(workspace.SharedData),
+		Tags:                 append([]string(nil), workspace.Tags...),
+		TemplateRef:          workspace.TemplateRef,
+		TemplateRepo:         schematics.NewTemplateRepoRequestFromResponse(workspace.TemplateRepo),
+		Type:                 append([]string(nil), workspace.Type...),
+	}
+	for i := range templateData {
+		createWorkspaceOptions.TemplateData = append(createWorkspaceOptions.TemplateData, *schematics.NewTemplateSourceDataRequestFromResponse(&templateData[i]))
+	}
+	if workspace.Agent != nil {
+		createWorkspaceOptions.AgentID = workspace.Agent.ID
+	}
+	return createWorkspaceOptions
+}
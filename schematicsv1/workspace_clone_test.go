@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schematicsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SchematicsV1 workspace clone`, func() {
+	var sourceServer *httptest.Server
+	var targetServer *httptest.Server
+	var schematicsService *schematicsv1.SchematicsV1
+	var targetService *schematicsv1.SchematicsV1
+	var created map[string]interface{}
+	var createdBy string
+	var source string
+
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == "/v1/workspaces/us-south.workspace.dev":
+				fmt.Fprint(res, source)
+			case req.Method == http.MethodPost && req.URL.Path == "/v1/workspaces":
+				Expect(json.NewDecoder(req.Body).Decode(&created)).To(Succeed())
+				createdBy = name
+				fmt.Fprint(res, `{"id": "eu-de.workspace.staging", "template_data": [{"id": "t2"}]}`)
+			default:
+				res.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	BeforeEach(func() {
+		created = nil
+		createdBy = ""
+		source = `{"id": "us-south.workspace.dev", "name": "app-dev", "resource_group": "dev", "location": "us-south",
+			"description": "demo", "tags": ["env:dev"], "type": ["terraform_v1.5"], "crn": "crn:dev",
+			"agent": {"id": "agent-dev", "name": "dev"},
+			"template_repo": {"url": "https://github.com/org/app", "branch": "dev", "repo_sha_value": "abc123", "full_url": "https://github.com/org/app/tree/dev"},
+			"template_data": [{"id": "t1", "folder": "infra", "type": "terraform_v1.5", "has_githubtoken": true,
+				"variablestore": [{"name": "size", "value": "small"}, {"name": "api_key", "secure": true, "value": "******"}, {"name": "password", "secure": true}],
+				"env_values": [{"name": "TF_LOG", "value": "debug"}, {"name": "TOKEN", "secure": true, "value": "******"}]}],
+			"workspace_status": {"frozen": true, "locked": true}}`
+		sourceServer = newServer("source")
+		targetServer = newServer("target")
+
+		var serviceErr error
+		schematicsService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           sourceServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		targetService, serviceErr = schematicsv1.NewSchematicsV1(&schematicsv1.SchematicsV1Options{
+			URL:           targetServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		sourceServer.Close()
+		targetServer.Close()
+	})
+
+	templateData := func() map[string]interface{} {
+		return created["template_data"].([]interface{})[0].(map[string]interface{})
+	}
+
+	It(`Clone a workspace with overrides into another location`, func() {
+		result, err := schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.dev", &schematicsv1.WorkspaceCloneOptions{
+			Name:          "app-staging",
+			Location:      "eu-de",
+			ResourceGroup: "staging",
+			Tags:          []string{"env:staging"},
+			AgentID:       "agent-staging",
+			Branch:        "main",
+			Variables:     map[string]string{"size": "large", "api_key": "k3y"},
+			Target:        targetService,
+		})
+		Expect(err).To(BeNil())
+		Expect(*result.Workspace.ID).To(Equal("eu-de.workspace.staging"))
+		Expect(result.UncopiedSecureValues).To(Equal([]string{"t1/password", "t1/TOKEN"}))
+		Expect(result.PendingTemplateUploads).To(BeEmpty())
+		Expect(createdBy).To(Equal("target"))
+
+		Expect(created["name"]).To(Equal("app-staging"))
+		Expect(created["location"]).To(Equal("eu-de"))
+		Expect(created["resource_group"]).To(Equal("staging"))
+		Expect(created["tags"]).To(Equal([]interface{}{"env:staging"}))
+		Expect(created["agent_id"]).To(Equal("agent-staging"))
+		Expect(created["description"]).To(Equal("demo"))
+		Expect(created).ToNot(HaveKey("workspace_status"))
+		Expect(created).ToNot(HaveKey("crn"))
+		Expect(created["template_repo"]).To(Equal(map[string]interface{}{"url": "https://github.com/org/app", "branch": "main"}))
+
+		template := templateData()
+		Expect(template["folder"]).To(Equal("infra"))
+		Expect(template).ToNot(HaveKey("has_githubtoken"))
+		Expect(template["variablestore"]).To(Equal([]interface{}{
+			map[string]interface{}{"name": "size", "value": "large"},
+			map[string]interface{}{"name": "api_key", "secure": true, "value": "k3y"},
+			map[string]interface{}{"name": "password", "secure": true},
+		}))
+		Expect(template["env_values"]).To(Equal([]interface{}{map[string]interface{}{"TF_LOG": "debug"}}))
+	})
+	It(`Clone a workspace unchanged`, func() {
+		result, err := schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.dev", nil)
+		Expect(err).To(BeNil())
+		Expect(result.UncopiedSecureValues).To(Equal([]string{"t1/api_key", "t1/password", "t1/TOKEN"}))
+		Expect(createdBy).To(Equal("source"))
+		Expect(created["name"]).To(Equal("app-dev"))
+		Expect(created["agent_id"]).To(Equal("agent-dev"))
+		Expect(created["template_repo"]).To(HaveKeyWithValue("repo_sha_value", "abc123"))
+	})
+	It(`Report templates that must be uploaded again`, func() {
+		source = `{"id": "us-south.workspace.dev", "name": "app-dev", "template_repo": {"has_uploadedgitrepotar": true}, "template_data": [{"id": "t1"}]}`
+		result, err := schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.dev", nil)
+		Expect(err).To(BeNil())
+		Expect(result.PendingTemplateUploads).To(Equal([]string{"t2"}))
+	})
+	It(`Fail on unknown variables and branches without a repository`, func() {
+		_, err := schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.dev", &schematicsv1.WorkspaceCloneOptions{Variables: map[string]string{"zone": "1"}})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("variable 'zone'"))
+
+		source = `{"id": "us-south.workspace.dev", "name": "app-dev"}`
+		_, err = schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.dev", &schematicsv1.WorkspaceCloneOptions{Branch: "main"})
+		Expect(err).ToNot(BeNil())
+
+		_, err = schematicsService.CloneWorkspace(context.Background(), "us-south.workspace.missing", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(created).To(BeNil())
+	})
+})